@@ -0,0 +1,27 @@
+package conv
+
+// truthyTokens and falsyTokens are the tokens recognized by ParseBool, in lowercase.
+var (
+	truthyTokens = [...]string{"1", "t", "true", "y", "yes", "on", "enable", "enabled"}
+	falsyTokens  = [...]string{"0", "f", "false", "n", "no", "off", "disable", "disabled"}
+)
+
+// ParseBool parses a boolean from a configuration string, ignoring surrounding ASCII whitespace and ASCII case.
+// Recognized tokens are "1", "t", "true", "y", "yes", "on", "enable", "enabled" for true and
+// "0", "f", "false", "n", "no", "off", "disable", "disabled" for false.
+// The second result is false for unrecognized input. It doesn't allocate.
+func ParseBool(s string) (value bool, ok bool) {
+	s = TrimSpaceASCII(s)
+
+	for _, token := range truthyTokens {
+		if len(s) == len(token) && equalFoldASCII(s, token) {
+			return true, true
+		}
+	}
+	for _, token := range falsyTokens {
+		if len(s) == len(token) && equalFoldASCII(s, token) {
+			return false, true
+		}
+	}
+	return false, false
+}
@@ -0,0 +1,43 @@
+package conv
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestParseBool(t *testing.T) {
+	for _, token := range []string{"1", "t", "true", "y", "yes", "on", "enable", "enabled"} {
+		for _, s := range []string{token, strings.ToUpper(token), " " + token + "\t"} {
+			v, ok := ParseBool(s)
+			assert.True(t, ok, "expected %q to be recognized", s)
+			assert.True(t, v, "expected %q to be true", s)
+		}
+	}
+
+	for _, token := range []string{"0", "f", "false", "n", "no", "off", "disable", "disabled"} {
+		for _, s := range []string{token, strings.ToUpper(token), " " + token + "\n"} {
+			v, ok := ParseBool(s)
+			assert.True(t, ok, "expected %q to be recognized", s)
+			assert.False(t, v, "expected %q to be false", s)
+		}
+	}
+
+	assert.True(t, func() bool { v, _ := ParseBool("TrUe"); return v }(), "expected mixed case to be recognized")
+}
+
+func TestParseBool_Rejected(t *testing.T) {
+	for _, s := range []string{"", " ", "2", "tru", "truee", "yess", "of", "nope", "true false", "ja", "ｔｒｕｅ"} {
+		v, ok := ParseBool(s)
+		assert.False(t, ok, "expected %q to be rejected", s)
+		assert.False(t, v, "expected rejected %q to be false", s)
+	}
+}
+
+func TestParseBool_NoAllocations(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = ParseBool(" Enabled ")
+		_, _ = ParseBool("garbage")
+	})
+	assert.Zero(t, allocs, "expected no allocations")
+}
@@ -0,0 +1,58 @@
+package conv
+
+// Buffer is a byte buffer for assembling short strings whose backing array is kept across Reset,
+// so a Buffer can be reused, e.g. from a sync.Pool, without reallocating:
+//
+//	var pool = sync.Pool{New: func() any { return new(conv.Buffer) }}
+//
+//	buf := pool.Get().(*conv.Buffer)
+//	buf.Reset()
+//	buf.WriteString("user:")
+//	buf.WriteString(id)
+//	key := strings.Clone(buf.String()) // copy before returning the buffer to the pool
+//	pool.Put(buf)
+//
+// The zero value is an empty buffer ready to use. A Buffer must not be copied after first use.
+type Buffer struct {
+	buf []byte
+}
+
+// Write appends p to the buffer. It always returns len(p) and a nil error.
+func (b *Buffer) Write(p []byte) (int, error) {
+	b.buf = append(b.buf, p...)
+	return len(p), nil
+}
+
+// WriteString appends s to the buffer. It always returns len(s) and a nil error.
+func (b *Buffer) WriteString(s string) (int, error) {
+	b.buf = append(b.buf, s...)
+	return len(s), nil
+}
+
+// WriteByte appends c to the buffer. It always returns a nil error.
+func (b *Buffer) WriteByte(c byte) error {
+	b.buf = append(b.buf, c)
+	return nil
+}
+
+// Reset empties the buffer, keeping its backing array for reuse.
+func (b *Buffer) Reset() {
+	b.buf = b.buf[:0]
+}
+
+// Len returns the number of bytes in the buffer.
+func (b *Buffer) Len() int {
+	return len(b.buf)
+}
+
+// Bytes returns the buffer content. The slice aliases the buffer and is valid only until the next mutation.
+func (b *Buffer) Bytes() []byte {
+	return b.buf
+}
+
+// String returns the buffer content as a string view without copying, using BytesToStr.
+// WARNING: The string aliases the buffer and is valid only until the next mutation (write or Reset):
+// later writes change its content. Use strings.Clone to keep it longer.
+func (b *Buffer) String() string {
+	return BytesToStr(b.buf)
+}
@@ -0,0 +1,95 @@
+package conv
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestBuffer(t *testing.T) {
+	var b Buffer
+	assert.Equal(t, "", b.String(), "expected zero value to be empty")
+	assert.Equal(t, 0, b.Len(), "expected zero length")
+
+	n, err := b.WriteString("hello")
+	assert.NoError(t, err)
+	assert.Equal(t, 5, n, "expected written length")
+	assert.NoError(t, b.WriteByte(','))
+	n, err = b.Write([]byte(" 世界"))
+	assert.NoError(t, err)
+	assert.Equal(t, 7, n, "expected written length")
+
+	assert.Equal(t, "hello, 世界", b.String(), "expected concatenated content")
+	assert.Equal(t, []byte("hello, 世界"), b.Bytes(), "expected concatenated bytes")
+	assert.Equal(t, 13, b.Len(), "expected content length")
+
+	var _ io.Writer = &b
+	var _ io.StringWriter = &b
+	var _ io.ByteWriter = &b
+}
+
+func TestBuffer_ReuseAfterReset(t *testing.T) {
+	var b Buffer
+	_, _ = b.WriteString(strings.Repeat("x", 64))
+	capacity := cap(b.Bytes())
+
+	b.Reset()
+	assert.Equal(t, "", b.String(), "expected empty buffer after Reset")
+	assert.Equal(t, capacity, cap(b.Bytes()), "expected backing array to be kept")
+
+	_, _ = b.WriteString("reused")
+	assert.Equal(t, "reused", b.String(), "expected new content after Reset")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		b.Reset()
+		_, _ = b.WriteString("user:")
+		_, _ = b.WriteString("42")
+		_ = b.String()
+	})
+	assert.Zero(t, allocs, "expected no allocations when reusing the buffer")
+}
+
+func TestBuffer_StringAliasesBuffer(t *testing.T) {
+	var b Buffer
+	_, _ = b.WriteString("first")
+	s := b.String()
+	kept := strings.Clone(s)
+
+	b.Reset()
+	_, _ = b.WriteString("other")
+
+	assert.Equal(t, "other", s, "expected view to reflect later mutations")
+	assert.Equal(t, "first", kept, "expected clone to be unaffected")
+}
+
+var bufferPool = sync.Pool{New: func() any { return new(Buffer) }}
+
+func BenchmarkBuffer(b *testing.B) {
+	parts := []string{"tenant:", "acme", "/user:", "42", "/session:", "abcdef"}
+
+	b.Run("pooled Buffer", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			buf := bufferPool.Get().(*Buffer)
+			buf.Reset()
+			for _, p := range parts {
+				_, _ = buf.WriteString(p)
+			}
+			_ = buf.String()
+			bufferPool.Put(buf)
+		}
+	})
+
+	b.Run("fresh strings.Builder", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var sb strings.Builder
+			for _, p := range parts {
+				sb.WriteString(p)
+			}
+			_ = sb.String()
+		}
+	})
+}
@@ -0,0 +1,26 @@
+package conv
+
+import "crypto/subtle"
+
+// ConstantTimeEqual reports whether a and b hold the same bytes, in time independent of where the first
+// difference occurs, making it suitable for comparing secrets such as API tokens.
+// a is compared through a StrToBytes view, so no conversion allocation is made.
+// As with crypto/subtle.ConstantTimeCompare, inputs of different lengths return false immediately;
+// only the length, not the content, may leak through timing.
+func ConstantTimeEqual(a string, b []byte) bool {
+	return subtle.ConstantTimeCompare(StrToBytes(a), b) == 1
+}
+
+// MatchAny returns the index of the first candidate equal to b, e.g. to dispatch on a token
+// against a fixed set of keywords, and false if none matches. Candidates of a different length
+// are skipped without comparing their content. b is compared through a BytesToStr view,
+// so no allocation is made. Unlike ConstantTimeEqual, MatchAny isn't suitable for secrets.
+func MatchAny(b []byte, candidates ...string) (int, bool) {
+	s := BytesToStr(b)
+	for i, c := range candidates {
+		if len(c) == len(s) && c == s {
+			return i, true
+		}
+	}
+	return -1, false
+}
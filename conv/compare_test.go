@@ -0,0 +1,104 @@
+package conv
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestConstantTimeEqual(t *testing.T) {
+	assert.True(t, ConstantTimeEqual("secret-token", []byte("secret-token")), "expected equal inputs to match")
+	assert.False(t, ConstantTimeEqual("secret-token", []byte("secret-tokeN")), "expected difference in last byte to fail")
+	assert.False(t, ConstantTimeEqual("secret-token", []byte("Secret-token")), "expected difference in first byte to fail")
+}
+
+func TestConstantTimeEqual_LengthDifference(t *testing.T) {
+	assert.False(t, ConstantTimeEqual("secret", []byte("secret-token")), "expected prefix of b not to match")
+	assert.False(t, ConstantTimeEqual("secret-token", []byte("secret")), "expected prefix of a not to match")
+	assert.False(t, ConstantTimeEqual("secret", nil), "expected nil b not to match")
+	assert.True(t, ConstantTimeEqual("", nil), "expected empty inputs to match")
+}
+
+func TestConstantTimeEqual_NoAllocations(t *testing.T) {
+	b := []byte("secret-token")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = ConstantTimeEqual("secret-token", b)
+	})
+	assert.Zero(t, allocs, "expected no allocations")
+}
+
+func TestMatchAny(t *testing.T) {
+	tests := []struct {
+		name       string
+		b          []byte
+		candidates []string
+		index      int
+		ok         bool
+	}{
+		{"match", []byte("PUT"), []string{"GET", "POST", "PUT", "DELETE"}, 2, true},
+		{"first match wins", []byte("a"), []string{"b", "a", "a"}, 1, true},
+		{"no match", []byte("PATCH"), []string{"GET", "POST", "PUT", "DELETE"}, -1, false},
+		{"same length no match", []byte("GOT"), []string{"GET", "PUT"}, -1, false},
+		{"case-sensitive", []byte("get"), []string{"GET"}, -1, false},
+		{"empty candidate list", []byte("GET"), nil, -1, false},
+		{"empty input", []byte{}, []string{"GET", ""}, 1, true},
+		{"nil input", nil, []string{""}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index, ok := MatchAny(tt.b, tt.candidates...)
+			assert.Equal(t, tt.index, index, "expected candidate index")
+			assert.Equal(t, tt.ok, ok, "expected match result")
+		})
+	}
+}
+
+func TestMatchAny_NoAllocation(t *testing.T) {
+	b := []byte("DELETE")
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = MatchAny(b, "GET", "POST", "PUT", "DELETE")
+	})
+	assert.Zero(t, allocs, "expected no allocation")
+}
+
+var matchAnyIndex int
+
+var matchAnyKeywords = []string{"select", "insert", "update", "delete", "create", "drop", "alter", "truncate"}
+
+func BenchmarkMatchAny(b *testing.B) {
+	token := []byte("truncate")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		matchAnyIndex, _ = MatchAny(token, matchAnyKeywords...)
+	}
+}
+
+func BenchmarkMatchAny_NaiveSwitch(b *testing.B) {
+	token := []byte("truncate")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		switch string(token) {
+		case "select":
+			matchAnyIndex = 0
+		case "insert":
+			matchAnyIndex = 1
+		case "update":
+			matchAnyIndex = 2
+		case "delete":
+			matchAnyIndex = 3
+		case "create":
+			matchAnyIndex = 4
+		case "drop":
+			matchAnyIndex = 5
+		case "alter":
+			matchAnyIndex = 6
+		case "truncate":
+			matchAnyIndex = 7
+		default:
+			matchAnyIndex = -1
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package conv
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// ParseDurationFlexible parses a duration from a configuration string, ignoring surrounding ASCII whitespace.
+// A bare integer is interpreted as a number of seconds, e.g. "30" is 30s; anything else is parsed
+// with time.ParseDuration, e.g. "5m" or "1h30m". Bare integers and valid durations are parsed without allocation.
+func ParseDurationFlexible(s string) (time.Duration, error) {
+	s = TrimSpaceASCII(s)
+
+	if isInteger(s) {
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || n > math.MaxInt64/int64(time.Second) || n < math.MinInt64/int64(time.Second) {
+			return 0, fmt.Errorf("invalid duration %q: seconds out of range", s)
+		}
+		return time.Duration(n) * time.Second, nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: %w", s, err)
+	}
+	return d, nil
+}
+
+// isInteger reports whether s is a decimal integer with an optional sign.
+func isInteger(s string) bool {
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+	if len(s) == 0 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
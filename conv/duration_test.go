@@ -0,0 +1,48 @@
+package conv
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestParseDurationFlexible(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected time.Duration
+	}{
+		{"seconds unit", "30s", 30 * time.Second},
+		{"bare integer", "30", 30 * time.Second},
+		{"minutes unit", "5m", 5 * time.Minute},
+		{"compound", "1h30m", 90 * time.Minute},
+		{"fraction", "1.5s", 1500 * time.Millisecond},
+		{"zero", "0", 0},
+		{"signed integer", "-10", -10 * time.Second},
+		{"whitespace", " 15 ", 15 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := ParseDurationFlexible(tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, d, "expected parsed duration")
+		})
+	}
+}
+
+func TestParseDurationFlexible_Invalid(t *testing.T) {
+	for _, s := range []string{"", "abc", "5x", "30 s", "1.5", "+", "99999999999999999999", "9223372037"} {
+		d, err := ParseDurationFlexible(s)
+		assert.Error(t, err, "expected %q to be rejected", s)
+		assert.Zero(t, d, "expected zero duration for %q", s)
+	}
+}
+
+func TestParseDurationFlexible_NoAllocations(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = ParseDurationFlexible("30")
+		_, _ = ParseDurationFlexible("5m")
+	})
+	assert.Zero(t, allocs, "expected no allocations")
+}
@@ -0,0 +1,28 @@
+package conv
+
+// FieldsView returns an iterator over the fields of s separated by sep.
+// Each call returns the next field and true, or an empty string and false once s is exhausted.
+// Empty fields produced by leading, trailing or repeated separators are skipped.
+//
+// The yielded fields are views into s, so no substrings or intermediate slices are allocated.
+// They stay valid for as long as s does; since Go strings are immutable, this only matters when s
+// itself was produced by an unsafe conversion such as BytesToStr and the source bytes are modified.
+func FieldsView(s string, sep byte) func() (string, bool) {
+	return func() (string, bool) {
+		for len(s) > 0 && s[0] == sep {
+			s = s[1:]
+		}
+		if len(s) == 0 {
+			return "", false
+		}
+
+		i := 0
+		for i < len(s) && s[i] != sep {
+			i++
+		}
+
+		field := s[:i]
+		s = s[i:]
+		return field, true
+	}
+}
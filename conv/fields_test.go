@@ -0,0 +1,53 @@
+package conv
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"unsafe"
+)
+
+func collectFields(s string, sep byte) []string {
+	var fields []string
+	next := FieldsView(s, sep)
+	for f, ok := next(); ok; f, ok = next() {
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+func TestFieldsView(t *testing.T) {
+	assert.Equal(t, []string{"a", "bb", "ccc"}, collectFields("a,bb,ccc", ','), "expected fields split by separator")
+	assert.Equal(t, []string{"a", "b"}, collectFields("  a   b ", ' '), "expected repeated separators to be skipped")
+}
+
+func TestFieldsView_EmptyInput(t *testing.T) {
+	assert.Empty(t, collectFields("", ','), "expected no fields for empty input")
+	assert.Empty(t, collectFields(",,,", ','), "expected no fields for separator-only input")
+}
+
+func TestFieldsView_TrailingSeparators(t *testing.T) {
+	assert.Equal(t, []string{"a", "b"}, collectFields("a,b,,", ','), "expected trailing separators to be ignored")
+}
+
+func TestFieldsView_SingleField(t *testing.T) {
+	s := "single"
+	next := FieldsView(s, ',')
+
+	f, ok := next()
+	assert.True(t, ok, "expected a single field")
+	assert.Equal(t, s, f, "expected the field to match the input")
+	assert.Equal(t, unsafe.StringData(s), unsafe.StringData(f), "expected shared underlying memory")
+
+	_, ok = next()
+	assert.False(t, ok, "expected iterator to be exhausted")
+}
+
+func TestFieldsView_NoAllocations(t *testing.T) {
+	s := "a b c d e f"
+	allocs := testing.AllocsPerRun(100, func() {
+		next := FieldsView(s, ' ')
+		for _, ok := next(); ok; _, ok = next() {
+		}
+	})
+	assert.LessOrEqual(t, allocs, float64(1), "expected at most the iterator itself to be allocated")
+}
@@ -0,0 +1,31 @@
+package conv
+
+// HasPrefixFold reports whether s begins with prefix, comparing ASCII letters case-insensitively.
+// Non-ASCII bytes are compared exactly. It doesn't allocate.
+func HasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && equalFoldASCII(s[:len(prefix)], prefix)
+}
+
+// HasSuffixFold reports whether s ends with suffix, comparing ASCII letters case-insensitively.
+// Non-ASCII bytes are compared exactly. It doesn't allocate.
+func HasSuffixFold(s, suffix string) bool {
+	return len(s) >= len(suffix) && equalFoldASCII(s[len(s)-len(suffix):], suffix)
+}
+
+// equalFoldASCII reports whether a and b of equal length are equal under ASCII case folding.
+func equalFoldASCII(a, b string) bool {
+	for i := 0; i < len(a); i++ {
+		if lowerASCII(a[i]) != lowerASCII(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// lowerASCII returns the lowercase form of an ASCII uppercase letter and c unchanged otherwise.
+func lowerASCII(c byte) byte {
+	if 'A' <= c && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
@@ -0,0 +1,36 @@
+package conv
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestHasPrefixFold(t *testing.T) {
+	assert.True(t, HasPrefixFold("Content-Type", "content-"), "expected case-insensitive prefix match")
+	assert.True(t, HasPrefixFold("content-type", "CONTENT-"), "expected case-insensitive prefix match")
+	assert.False(t, HasPrefixFold("Accept", "content-"), "expected no match for different prefix")
+	assert.False(t, HasPrefixFold("con", "content-"), "expected no match when prefix is longer than s")
+	assert.True(t, HasPrefixFold("anything", ""), "expected empty prefix to match")
+	assert.True(t, HasPrefixFold("", ""), "expected empty prefix to match empty string")
+}
+
+func TestHasSuffixFold(t *testing.T) {
+	assert.True(t, HasSuffixFold("application/JSON", "/json"), "expected case-insensitive suffix match")
+	assert.False(t, HasSuffixFold("application/xml", "/json"), "expected no match for different suffix")
+	assert.False(t, HasSuffixFold("json", "/json"), "expected no match when suffix is longer than s")
+	assert.True(t, HasSuffixFold("anything", ""), "expected empty suffix to match")
+}
+
+func TestFold_NonASCII(t *testing.T) {
+	assert.True(t, HasPrefixFold("Über-Header", "Über-"), "expected exact non-ASCII bytes to match")
+	assert.False(t, HasPrefixFold("über-header", "Über-"), "expected non-ASCII bytes to compare exactly")
+	assert.False(t, HasSuffixFold("STRASSE", "ße"), "expected no Unicode folding")
+}
+
+func TestFold_NoAllocations(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = HasPrefixFold("Content-Type", "content-")
+		_ = HasSuffixFold("Content-Type", "-TYPE")
+	})
+	assert.Zero(t, allocs, "expected no allocations")
+}
@@ -0,0 +1,19 @@
+package conv
+
+// FNV-1a 64-bit parameters, as in hash/fnv.
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+// HashString returns the 64-bit FNV-1a hash of s, identical to hash/fnv.New64a over []byte(s),
+// without allocating. The hash is stable across processes and platforms, making it suitable for
+// sharding and sampling keys, but it isn't cryptographically secure.
+func HashString(s string) uint64 {
+	h := uint64(fnvOffset64)
+	for _, b := range StrToBytes(s) {
+		h ^= uint64(b)
+		h *= fnvPrime64
+	}
+	return h
+}
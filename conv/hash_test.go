@@ -0,0 +1,45 @@
+package conv
+
+import (
+	"github.com/stretchr/testify/assert"
+	"hash/fnv"
+	"strings"
+	"testing"
+)
+
+func TestHashString(t *testing.T) {
+	for _, s := range []string{"", "a", "hello, world", "héllo, 世界", strings.Repeat("x", 1024)} {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(s))
+
+		assert.Equal(t, h.Sum64(), HashString(s), "expected hash to match hash/fnv for %q", s)
+	}
+}
+
+func TestHashString_Deterministic(t *testing.T) {
+	a := "shard-key"
+	b := strings.Clone(a)
+
+	assert.Equal(t, HashString(a), HashString(a), "expected repeated calls to hash equally")
+	assert.Equal(t, HashString(a), HashString(b), "expected equal strings to hash equally")
+	assert.NotEqual(t, HashString("shard-key-1"), HashString("shard-key-2"), "expected different strings to hash differently")
+	assert.Equal(t, uint64(0xcbf29ce484222325), HashString(""), "expected empty string to hash to the FNV offset basis")
+}
+
+func TestHashString_NoAllocations(t *testing.T) {
+	s := strings.Repeat("x", 1024)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = HashString(s)
+	})
+	assert.Zero(t, allocs, "expected no allocations")
+}
+
+func BenchmarkHashString(b *testing.B) {
+	s := strings.Repeat("hello, world ", 8)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = HashString(s)
+	}
+}
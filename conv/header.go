@@ -0,0 +1,51 @@
+package conv
+
+// CanonicalizeHeaderKey returns the canonical MIME form of the header key s, following the rules of
+// net/textproto.CanonicalMIMEHeaderKey: the first letter and any letter following a hyphen are upper case,
+// the rest lower case, e.g. "content-type" becomes "Content-Type". If s is already canonical, or contains
+// a space or a byte that isn't valid in a header field name, it is returned unchanged without allocation;
+// otherwise the canonical copy costs a single allocation.
+func CanonicalizeHeaderKey(s string) string {
+	canonical := true
+	upper := true
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !isTokenByte(c) {
+			return s
+		}
+		if upper && 'a' <= c && c <= 'z' || !upper && 'A' <= c && c <= 'Z' {
+			canonical = false
+		}
+		upper = c == '-'
+	}
+	if canonical {
+		return s
+	}
+
+	b := make([]byte, len(s))
+	upper = true
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if upper && 'a' <= c && c <= 'z' {
+			c -= 'a' - 'A'
+		} else if !upper {
+			c = lowerASCII(c)
+		}
+		b[i] = c
+		upper = c == '-'
+	}
+	return BytesToStr(b)
+}
+
+// isTokenByte reports whether c is valid in an HTTP header field name, as defined by RFC 7230 "tchar".
+func isTokenByte(c byte) bool {
+	switch {
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		return true
+	}
+	switch c {
+	case '!', '#', '$', '%', '&', '\'', '*', '+', '-', '.', '^', '_', '`', '|', '~':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package conv
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/textproto"
+	"testing"
+	"unsafe"
+)
+
+func TestCanonicalizeHeaderKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"already canonical", "Content-Type", "Content-Type"},
+		{"lowercase", "content-type", "Content-Type"},
+		{"uppercase", "X-REQUEST-ID", "X-Request-Id"},
+		{"mixed", "x-fOrWaRdEd-fOr", "X-Forwarded-For"},
+		{"digits", "x-b3-traceid", "X-B3-Traceid"},
+		{"leading hyphen", "-foo", "-Foo"},
+		{"double hyphen", "a--b", "A--B"},
+		{"symbols", "x_custom.key", "X_custom.key"},
+		{"empty", "", ""},
+		{"space", "content type", "content type"},
+		{"colon", "content-type:", "content-type:"},
+		{"non-ascii", "contént-type", "contént-type"},
+		{"control", "content-type\n", "content-type\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CanonicalizeHeaderKey(tt.input)
+			assert.Equal(t, tt.expected, got, "expected canonical key")
+			assert.Equal(t, textproto.CanonicalMIMEHeaderKey(tt.input), got, "expected output to match net/textproto")
+		})
+	}
+}
+
+func TestCanonicalizeHeaderKey_Unchanged(t *testing.T) {
+	for _, s := range []string{"Content-Type", "content type", "contént-type"} {
+		got := CanonicalizeHeaderKey(s)
+		assert.Equal(t, unsafe.StringData(s), unsafe.StringData(got), "expected %q to be returned unchanged", s)
+
+		allocs := testing.AllocsPerRun(100, func() {
+			_ = CanonicalizeHeaderKey(s)
+		})
+		assert.Zero(t, allocs, "expected no allocations for %q", s)
+	}
+}
+
+func TestCanonicalizeHeaderKey_SingleAllocation(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = CanonicalizeHeaderKey("content-type")
+	})
+	assert.Equal(t, float64(1), allocs, "expected a single allocation")
+}
@@ -0,0 +1,18 @@
+package conv
+
+import "encoding/hex"
+
+// HexEncodeAppend appends the hexadecimal encoding of src to dst and returns the extended buffer.
+// Reusing dst across calls avoids the per-call allocation of hex.EncodeToString.
+func HexEncodeAppend(dst, src []byte) []byte {
+	return hex.AppendEncode(dst, src)
+}
+
+// HexDecodeAppend appends the bytes decoded from the hexadecimal src to dst and returns the extended buffer.
+// It returns hex.ErrLength if src has an odd length and a hex.InvalidByteError if src contains a non-hex byte.
+// On error, the returned buffer contains the bytes decoded before the error.
+//
+// Combined with StrToBytes, hex strings can be decoded without copying them first.
+func HexDecodeAppend(dst, src []byte) ([]byte, error) {
+	return hex.AppendDecode(dst, src)
+}
@@ -0,0 +1,47 @@
+package conv
+
+import (
+	"encoding/hex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestHexEncodeAppend(t *testing.T) {
+	dst := []byte("id=")
+	dst = HexEncodeAppend(dst, []byte{0xde, 0xad, 0xbe, 0xef})
+
+	assert.Equal(t, "id=deadbeef", string(dst), "expected hex encoding appended to dst")
+}
+
+func TestHexDecodeAppend(t *testing.T) {
+	dst, err := HexDecodeAppend([]byte{0x01}, []byte("DEADbeef"))
+
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0xde, 0xad, 0xbe, 0xef}, dst, "expected decoded bytes appended to dst")
+}
+
+func TestHexDecodeAppend_Errors(t *testing.T) {
+	_, err := HexDecodeAppend(nil, []byte("abc"))
+	assert.ErrorIs(t, err, hex.ErrLength, "expected error for odd-length input")
+
+	_, err = HexDecodeAppend(nil, StrToBytes("zz"))
+	var invalidByte hex.InvalidByteError
+	assert.ErrorAs(t, err, &invalidByte, "expected error for invalid hex byte")
+}
+
+func TestHexRoundTrip(t *testing.T) {
+	src := []byte{0x00, 0x01, 0x7f, 0x80, 0xff}
+	buf := make([]byte, 0, 64)
+
+	encoded := HexEncodeAppend(buf[:0], src)
+	decoded, err := HexDecodeAppend(nil, encoded)
+
+	require.NoError(t, err)
+	assert.Equal(t, src, decoded, "expected round-trip to preserve data")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = HexEncodeAppend(buf[:0], src)
+	})
+	assert.Zero(t, allocs, "expected no allocations when dst has enough capacity")
+}
@@ -0,0 +1,45 @@
+package conv
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ParseIntsInto parses the integers in s separated by sep and appends them to dst, returning the extended slice.
+// Surrounding ASCII whitespace of each token is ignored, as are empty tokens produced by leading,
+// trailing or repeated separators. Reusing dst across calls avoids allocating intermediate
+// substrings or slices, unlike strings.Split followed by strconv.Atoi.
+//
+// On a parse failure, it returns the integers parsed so far and an error naming the offending token.
+func ParseIntsInto(dst []int, s string, sep byte) ([]int, error) {
+	next := FieldsView(s, sep)
+	for token, ok := next(); ok; token, ok = next() {
+		token = TrimSpaceASCII(token)
+		if token == "" {
+			continue
+		}
+
+		n, err := strconv.Atoi(token)
+		if err != nil {
+			return dst, fmt.Errorf("invalid integer token %q: %w", token, err)
+		}
+		dst = append(dst, n)
+	}
+	return dst, nil
+}
+
+// ParseIntBytes parses b as a base-10 signed 64-bit integer, like strconv.ParseInt(string(b), 10, 64),
+// but through a BytesToStr view, so no string is allocated on success. An optional sign and leading zeros
+// are accepted. Errors are *strconv.NumError values reporting strconv.ErrSyntax or strconv.ErrRange;
+// they hold a copy of the input, so b may be reused afterward.
+func ParseIntBytes(b []byte) (int64, error) {
+	return strconv.ParseInt(BytesToStr(b), 10, 64)
+}
+
+// ParseUintBytes parses b as a base-10 unsigned 64-bit integer, like strconv.ParseUint(string(b), 10, 64),
+// but through a BytesToStr view, so no string is allocated on success. Leading zeros are accepted, a sign isn't.
+// Errors are *strconv.NumError values reporting strconv.ErrSyntax or strconv.ErrRange;
+// they hold a copy of the input, so b may be reused afterward.
+func ParseUintBytes(b []byte) (uint64, error) {
+	return strconv.ParseUint(BytesToStr(b), 10, 64)
+}
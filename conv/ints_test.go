@@ -0,0 +1,155 @@
+package conv
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestParseIntsInto(t *testing.T) {
+	dst, err := ParseIntsInto([]int{0}, "1, -2,3", ',')
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1, -2, 3}, dst, "expected parsed ints appended to dst")
+}
+
+func TestParseIntsInto_EmptyInput(t *testing.T) {
+	dst, err := ParseIntsInto(nil, "", ',')
+
+	require.NoError(t, err)
+	assert.Empty(t, dst, "expected no ints for empty input")
+}
+
+func TestParseIntsInto_TrailingSeparators(t *testing.T) {
+	dst, err := ParseIntsInto(nil, "1,2,, ,", ',')
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, dst, "expected empty tokens to be ignored")
+}
+
+func TestParseIntsInto_InvalidToken(t *testing.T) {
+	dst, err := ParseIntsInto(nil, "1,two,3", ',')
+
+	require.Error(t, err)
+	assert.Equal(t, []int{1}, dst, "expected ints parsed before the invalid token")
+	assert.Contains(t, err.Error(), `"two"`, "expected error to name the offending token")
+	assert.ErrorIs(t, err, strconv.ErrSyntax, "expected wrapped strconv error")
+
+	_, err = ParseIntsInto(nil, "99999999999999999999", ',')
+	assert.ErrorIs(t, err, strconv.ErrRange, "expected range error for overflowing token")
+}
+
+func TestParseIntsInto_NoAllocations(t *testing.T) {
+	buf := make([]int, 0, 8)
+	s := "1,2,3,4,5"
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, _ = ParseIntsInto(buf[:0], s, ',')
+	})
+	assert.LessOrEqual(t, allocs, float64(1), "expected at most the field iterator to be allocated")
+}
+
+func TestParseIntBytes(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		expected int64
+		err      error
+	}{
+		{"positive", "42", 42, nil},
+		{"negative", "-42", -42, nil},
+		{"explicit plus", "+7", 7, nil},
+		{"leading zeros", "007", 7, nil},
+		{"negative leading zeros", "-0010", -10, nil},
+		{"max", "9223372036854775807", math.MaxInt64, nil},
+		{"min", "-9223372036854775808", math.MinInt64, nil},
+		{"overflow", "9223372036854775808", math.MaxInt64, strconv.ErrRange},
+		{"underflow", "-9223372036854775809", math.MinInt64, strconv.ErrRange},
+		{"empty", "", 0, strconv.ErrSyntax},
+		{"sign only", "-", 0, strconv.ErrSyntax},
+		{"invalid digit", "12a", 0, strconv.ErrSyntax},
+		{"whitespace", " 1", 0, strconv.ErrSyntax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := ParseIntBytes([]byte(tt.in))
+			assert.Equal(t, tt.expected, n, "expected parsed value")
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.err, "expected error kind")
+			assert.Contains(t, err.Error(), strconv.Quote(tt.in), "expected error to name the input")
+		})
+	}
+}
+
+func TestParseUintBytes(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		expected uint64
+		err      error
+	}{
+		{"positive", "42", 42, nil},
+		{"leading zeros", "0042", 42, nil},
+		{"max", "18446744073709551615", math.MaxUint64, nil},
+		{"overflow", "18446744073709551616", math.MaxUint64, strconv.ErrRange},
+		{"negative", "-1", 0, strconv.ErrSyntax},
+		{"empty", "", 0, strconv.ErrSyntax},
+		{"invalid digit", "4x2", 0, strconv.ErrSyntax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := ParseUintBytes([]byte(tt.in))
+			assert.Equal(t, tt.expected, n, "expected parsed value")
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.err, "expected error kind")
+		})
+	}
+}
+
+func TestParseIntBytes_ErrorOutlivesInput(t *testing.T) {
+	b := []byte("12a")
+	_, err := ParseIntBytes(b)
+	require.Error(t, err)
+
+	copy(b, "xyz")
+	assert.Contains(t, err.Error(), `"12a"`, "expected the error to keep its own copy of the input")
+}
+
+func TestParseIntBytes_NoAllocations(t *testing.T) {
+	b := []byte("-1234567890")
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = ParseIntBytes(b)
+		_, _ = ParseUintBytes(b[1:])
+	})
+	assert.Zero(t, allocs, "expected no allocations")
+}
+
+var parseIntBytesSink int64
+
+func BenchmarkParseIntBytes(b *testing.B) {
+	buf := []byte(`{"id":1234567890123}`)[6:19]
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		parseIntBytesSink, _ = ParseIntBytes(buf)
+	}
+}
+
+func BenchmarkParseIntBytes_StringConversion(b *testing.B) {
+	buf := []byte(`{"id":1234567890123}`)[6:19]
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		parseIntBytesSink, _ = strconv.ParseInt(string(buf), 10, 64)
+	}
+}
@@ -0,0 +1,84 @@
+package conv
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+// marshalJSONString encodes s with encoding/json, HTML escaping disabled.
+func marshalJSONString(t testing.TB, s string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	require.NoError(t, enc.Encode(s))
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+func TestAppendJSONString(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"empty", "", `""`},
+		{"plain", "hello", `"hello"`},
+		{"quotes", `say "hi"`, `"say \"hi\""`},
+		{"backslash", `C:\dir`, `"C:\\dir"`},
+		{"newlines", "a\nb\r\tc", `"a\nb\r\tc"`},
+		{"backspace", "a\bb", `"a\bb"`},
+		{"form feed", "a\fb", `"a\fb"`},
+		{"control", "\x00\x1f\x7f", `"\u0000\u001f` + "\x7f" + `"`},
+		{"unicode", "héllo, 世界 🌍", `"héllo, 世界 🌍"`},
+		{"separators", "a\u2028b\u2029c", `"a\u2028b\u2029c"`},
+		{"invalid utf8", "a\xffb", "\"a\uFFFDb\""},
+		{"html", "<a&b>", `"<a&b>"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := string(AppendJSONString(nil, tt.input))
+			assert.Equal(t, tt.expected, got, "expected escaped JSON string")
+			assert.Equal(t, marshalJSONString(t, tt.input), got, "expected output to match encoding/json")
+		})
+	}
+}
+
+func TestAppendJSONString_Append(t *testing.T) {
+	dst := []byte(`{"k":`)
+	dst = AppendJSONString(dst, "v\n")
+	dst = append(dst, '}')
+
+	assert.Equal(t, `{"k":"v\n"}`, string(dst), "expected string to be appended to dst")
+	assert.True(t, json.Valid(dst), "expected valid JSON")
+}
+
+func TestAppendJSONString_NoAllocations(t *testing.T) {
+	dst := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		dst = AppendJSONString(dst[:0], "say \"hi\"\n世界\x01")
+	})
+	assert.Zero(t, allocs, "expected no allocations with sufficient capacity")
+}
+
+func BenchmarkAppendJSONString(b *testing.B) {
+	s := strings.Repeat(`héllo, "world"`+"\n", 16)
+
+	b.Run("AppendJSONString", func(b *testing.B) {
+		b.ReportAllocs()
+		dst := make([]byte, 0, 1024)
+		for i := 0; i < b.N; i++ {
+			dst = AppendJSONString(dst[:0], s)
+		}
+	})
+
+	b.Run("json.Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = json.Marshal(s)
+		}
+	})
+}
@@ -0,0 +1,28 @@
+package conv
+
+import "bytes"
+
+// Lines returns an iterator over the lines of b, splitting on "\n" and stripping a "\r" before it,
+// like bufio.ScanLines. Each call returns the next line and true, or nil and false once b is exhausted.
+// A trailing newline doesn't produce an extra empty line; empty lines in between are yielded.
+//
+// The yielded lines alias b: no copies or intermediate slices are allocated, and modifying b
+// changes the yielded lines. Copy a line, e.g. with bytes.Clone, to keep it beyond the lifetime of b.
+func Lines(b []byte) func() ([]byte, bool) {
+	return func() ([]byte, bool) {
+		if len(b) == 0 {
+			return nil, false
+		}
+
+		var line []byte
+		if i := bytes.IndexByte(b, '\n'); i >= 0 {
+			line, b = b[:i], b[i+1:]
+			if n := len(line); n > 0 && line[n-1] == '\r' {
+				line = line[:n-1]
+			}
+		} else {
+			line, b = b, nil
+		}
+		return line, true
+	}
+}
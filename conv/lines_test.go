@@ -0,0 +1,64 @@
+package conv
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func collectLines(b []byte) []string {
+	var lines []string
+	next := Lines(b)
+	for l, ok := next(); ok; l, ok = next() {
+		lines = append(lines, string(l))
+	}
+	return lines
+}
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{"trailing newline", "a\nbb\n", []string{"a", "bb"}},
+		{"no trailing newline", "a\nbb", []string{"a", "bb"}},
+		{"crlf", "a\r\nbb\r\n", []string{"a", "bb"}},
+		{"mixed", "a\r\nbb\nccc", []string{"a", "bb", "ccc"}},
+		{"empty lines", "\na\n\nb\n", []string{"", "a", "", "b"}},
+		{"lone carriage return", "a\rb\n", []string{"a\rb"}},
+		{"single newline", "\n", []string{""}},
+		{"empty input", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, collectLines([]byte(tt.input)), "expected lines to match")
+		})
+	}
+}
+
+func TestLines_Nil(t *testing.T) {
+	assert.Empty(t, collectLines(nil), "expected no lines for nil input")
+}
+
+func TestLines_Alias(t *testing.T) {
+	b := []byte("first\nsecond")
+	next := Lines(b)
+
+	first, ok := next()
+	assert.True(t, ok, "expected a line")
+	assert.Same(t, &b[0], &first[0], "expected the line to alias the input")
+
+	b[0] = 'F'
+	assert.Equal(t, "First", string(first), "expected modifications of the input to be visible")
+}
+
+func TestLines_NoAllocations(t *testing.T) {
+	b := []byte("a\r\nbb\nccc\n")
+	allocs := testing.AllocsPerRun(100, func() {
+		next := Lines(b)
+		for _, ok := next(); ok; _, ok = next() {
+		}
+	})
+	assert.Zero(t, allocs, "expected no allocations")
+}
@@ -0,0 +1,48 @@
+package conv
+
+import (
+	"net/url"
+	"strings"
+)
+
+// QueryUnescapeView decodes a percent-encoded query component like url.QueryUnescape,
+// converting '+' into a space. If s contains neither '%' nor '+', it is returned unchanged without allocation;
+// otherwise a decoded copy is allocated. It returns false if s contains a malformed escape.
+func QueryUnescapeView(s string) (string, bool) {
+	if strings.IndexByte(s, '%') < 0 && strings.IndexByte(s, '+') < 0 {
+		return s, true
+	}
+
+	decoded, err := url.QueryUnescape(s)
+	if err != nil {
+		return "", false
+	}
+	return decoded, true
+}
+
+// AppendQuery appends key=value to dst, both escaped like url.QueryEscape, and returns the extended buffer.
+// Letters, digits and "-_.~" are kept as is, spaces become '+' and other bytes are percent-encoded.
+// Separating pairs with '&' is left to the caller, so pairs are appended in the caller's order,
+// without the sorting and intermediate strings of url.Values.Encode. Reusing dst avoids any allocation.
+func AppendQuery(dst []byte, key, value string) []byte {
+	dst = appendQueryEscape(dst, key)
+	dst = append(dst, '=')
+	return appendQueryEscape(dst, value)
+}
+
+// appendQueryEscape appends s escaped like url.QueryEscape to dst.
+func appendQueryEscape(dst []byte, s string) []byte {
+	const upperHex = "0123456789ABCDEF"
+
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			dst = append(dst, c)
+		case c == ' ':
+			dst = append(dst, '+')
+		default:
+			dst = append(dst, '%', upperHex[c>>4], upperHex[c&0xf])
+		}
+	}
+	return dst
+}
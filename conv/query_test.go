@@ -0,0 +1,122 @@
+package conv
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/url"
+	"strconv"
+	"testing"
+	"unsafe"
+)
+
+func TestQueryUnescapeView(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+		ok   bool
+	}{
+		{name: "empty", in: "", want: "", ok: true},
+		{name: "plain", in: "hello-world_42", want: "hello-world_42", ok: true},
+		{name: "plus-encoded", in: "hello+world", want: "hello world", ok: true},
+		{name: "percent-encoded", in: "caf%C3%A9%20%26%3D", want: "café &=", ok: true},
+		{name: "mixed", in: "a+b%2Bc", want: "a b+c", ok: true},
+		{name: "malformed escape", in: "100%zz", want: "", ok: false},
+		{name: "truncated escape", in: "abc%2", want: "", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := QueryUnescapeView(tt.in)
+			assert.Equal(t, tt.ok, ok, "unexpected ok")
+			assert.Equal(t, tt.want, got, "unexpected result")
+		})
+	}
+}
+
+func TestQueryUnescapeView_NoAllocationWhenUnchanged(t *testing.T) {
+	s := "plain-value"
+
+	got, ok := QueryUnescapeView(s)
+	assert.True(t, ok)
+	assert.Equal(t, unsafe.StringData(s), unsafe.StringData(got), "expected input to be returned unchanged")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = QueryUnescapeView(s)
+	})
+	assert.Zero(t, allocs, "expected no allocations")
+}
+
+func TestAppendQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		value    string
+		expected string
+	}{
+		{"plain", "page", "2", "page=2"},
+		{"unreserved", "a-b_c.d~e", "AZaz09", "a-b_c.d~e=AZaz09"},
+		{"space", "q", "hello world", "q=hello+world"},
+		{"reserved", "filter", "a&b=c?d#e/f+g%h", "filter=a%26b%3Dc%3Fd%23e%2Ff%2Bg%25h"},
+		{"escaped key", "user name", "x", "user+name=x"},
+		{"unicode", "city", "Zürich", "city=Z%C3%BCrich"},
+		{"control", "v", "a\nb", "v=a%0Ab"},
+		{"empty", "", "", "="},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := string(AppendQuery(nil, tt.key, tt.value))
+			assert.Equal(t, tt.expected, got, "expected escaped pair")
+			assert.Equal(t, url.QueryEscape(tt.key)+"="+url.QueryEscape(tt.value), got, "expected output to match url.QueryEscape")
+
+			parsed, err := url.ParseQuery(got)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.value, parsed.Get(tt.key), "expected pair to round-trip")
+		})
+	}
+}
+
+func TestAppendQuery_Pairs(t *testing.T) {
+	dst := []byte("https://example.com/search?")
+	dst = AppendQuery(dst, "q", "a b")
+	dst = append(dst, '&')
+	dst = AppendQuery(dst, "lang", "en")
+
+	assert.Equal(t, "https://example.com/search?q=a+b&lang=en", string(dst), "expected pairs in the appended order")
+}
+
+func TestAppendQuery_NoAllocations(t *testing.T) {
+	dst := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		dst = AppendQuery(dst[:0], "filter", "a&b=c d")
+	})
+	assert.Zero(t, allocs, "expected no allocations with sufficient capacity")
+}
+
+func BenchmarkAppendQuery(b *testing.B) {
+	values := url.Values{}
+	for i := range 8 {
+		values.Set("key"+strconv.Itoa(i), "value with spaces & symbols "+strconv.Itoa(i))
+	}
+
+	b.Run("AppendQuery", func(b *testing.B) {
+		b.ReportAllocs()
+		dst := make([]byte, 0, 1024)
+		for i := 0; i < b.N; i++ {
+			dst = dst[:0]
+			for k, v := range values {
+				if len(dst) > 0 {
+					dst = append(dst, '&')
+				}
+				dst = AppendQuery(dst, k, v[0])
+			}
+		}
+	})
+
+	b.Run("url.Values", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = values.Encode()
+		}
+	})
+}
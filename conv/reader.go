@@ -0,0 +1,14 @@
+package conv
+
+import (
+	"io"
+	"strings"
+)
+
+// StringReader returns an io.Reader reading from s without the []byte(s) copy.
+// It is a strings.Reader: it never exposes the memory of s to the writers and readers it is used with,
+// unlike a bytes.Reader over a StrToBytes view, which would hand them a writable alias of the immutable string.
+// The returned reader also implements io.ReaderAt, io.Seeker, io.WriterTo, io.ByteScanner and io.RuneScanner.
+func StringReader(s string) io.Reader {
+	return strings.NewReader(s)
+}
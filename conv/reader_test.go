@@ -0,0 +1,52 @@
+package conv
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestStringReader(t *testing.T) {
+	s := "hello, 世界"
+
+	data, err := io.ReadAll(StringReader(s))
+	require.NoError(t, err)
+	assert.Equal(t, s, string(data), "expected full content to be read")
+	assert.Equal(t, "hello, 世界", s, "expected source string to be unchanged")
+
+	data, err = io.ReadAll(StringReader(""))
+	require.NoError(t, err)
+	assert.Empty(t, data, "expected empty reader to return no data")
+}
+
+func TestStringReader_PartialReads(t *testing.T) {
+	r := StringReader("abcdefgh")
+	buf := make([]byte, 3)
+
+	n, err := r.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, "abc", string(buf[:n]), "expected first chunk")
+
+	n, err = r.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, "def", string(buf[:n]), "expected second chunk")
+
+	n, err = r.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, "gh", string(buf[:n]), "expected short final chunk")
+
+	n, err = r.Read(buf)
+	assert.Equal(t, 0, n, "expected no data after the end")
+	assert.ErrorIs(t, err, io.EOF, "expected io.EOF after the end")
+}
+
+func TestStringReader_NoCopy(t *testing.T) {
+	s := strings.Repeat("x", 1024)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = io.Copy(io.Discard, StringReader(s))
+	})
+	assert.LessOrEqual(t, allocs, float64(1), "expected no allocation beyond the reader itself")
+}
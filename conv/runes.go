@@ -0,0 +1,44 @@
+package conv
+
+import "unicode/utf8"
+
+// RunesToStr converts a rune slice to a UTF-8 encoded string with a single allocation sized by the encoded length.
+// Invalid runes are encoded as utf8.RuneError, as with string(r).
+// Unlike StrToBytes and BytesToStr the result is a genuine copy: runes and UTF-8 bytes can't share memory.
+func RunesToStr(r []rune) string {
+	n := 0
+	for _, c := range r {
+		if l := utf8.RuneLen(c); l > 0 {
+			n += l
+		} else {
+			n += utf8.RuneLen(utf8.RuneError)
+		}
+	}
+	if n == 0 {
+		return ""
+	}
+
+	buf := make([]byte, 0, n)
+	for _, c := range r {
+		buf = utf8.AppendRune(buf, c)
+	}
+
+	return BytesToStr(buf)
+}
+
+// StrToRunes converts a string to a rune slice with a single allocation sized by the rune count.
+// Invalid UTF-8 bytes are decoded as utf8.RuneError, as with []rune(s).
+// The result is a genuine copy and may be modified freely.
+func StrToRunes(s string) []rune {
+	n := utf8.RuneCountInString(s)
+	if n == 0 {
+		return nil
+	}
+
+	r := make([]rune, 0, n)
+	for _, c := range s {
+		r = append(r, c)
+	}
+
+	return r
+}
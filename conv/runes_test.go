@@ -0,0 +1,85 @@
+package conv
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestRunesToStr(t *testing.T) {
+	assert.Equal(t, "", RunesToStr(nil), "expected empty string for nil input")
+	assert.Equal(t, "", RunesToStr([]rune{}), "expected empty string for empty input")
+	assert.Equal(t, "hello", RunesToStr([]rune("hello")), "expected ASCII runes to round-trip")
+	assert.Equal(t, "héllo, 世界 🌍", RunesToStr([]rune("héllo, 世界 🌍")), "expected multibyte runes to round-trip")
+	assert.Equal(t, string([]rune{'a', -1, 0xD800, 'b'}), RunesToStr([]rune{'a', -1, 0xD800, 'b'}), "expected invalid runes to match string(r)")
+}
+
+func TestRunesToStr_Copy(t *testing.T) {
+	r := []rune("héllo")
+	s := RunesToStr(r)
+	r[0] = 'j'
+
+	assert.Equal(t, "héllo", s, "expected string not to alias the rune slice")
+}
+
+func TestRunesToStr_SingleAllocation(t *testing.T) {
+	r := []rune(strings.Repeat("世界", 64))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = RunesToStr(r)
+	})
+	assert.Equal(t, float64(1), allocs, "expected a single allocation")
+}
+
+func TestStrToRunes(t *testing.T) {
+	assert.Empty(t, StrToRunes(""), "expected empty slice for empty input")
+	assert.Equal(t, []rune("hello"), StrToRunes("hello"), "expected ASCII string to convert")
+	assert.Equal(t, []rune("héllo, 世界 🌍"), StrToRunes("héllo, 世界 🌍"), "expected multibyte string to convert")
+	assert.Equal(t, []rune{'a', utf8.RuneError, 'b'}, StrToRunes("a\xffb"), "expected invalid bytes to decode as RuneError")
+}
+
+func TestStrToRunes_Copy(t *testing.T) {
+	s := "héllo"
+	r := StrToRunes(s)
+	r[0] = 'j'
+
+	assert.Equal(t, "héllo", s, "expected string to be unchanged")
+	assert.Equal(t, "jéllo", string(r), "expected rune slice to be modifiable")
+}
+
+func BenchmarkRunesToStr(b *testing.B) {
+	r := []rune(strings.Repeat("héllo, 世界 🌍 ", 16))
+
+	b.Run("RunesToStr", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = RunesToStr(r)
+		}
+	})
+
+	b.Run("string", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = string(r)
+		}
+	})
+}
+
+func BenchmarkStrToRunes(b *testing.B) {
+	s := strings.Repeat("héllo, 世界 🌍 ", 16)
+
+	b.Run("StrToRunes", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = StrToRunes(s)
+		}
+	})
+
+	b.Run("runes", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = []rune(s)
+		}
+	})
+}
@@ -0,0 +1,22 @@
+package conv
+
+import "unicode/utf8"
+
+// SafeSlice returns s[start:end] if the range is within s and both boundaries fall on rune boundaries,
+// so the result never splits a multibyte UTF-8 sequence. Otherwise, it returns an empty string and false.
+// The result shares the memory of s; no allocation is made.
+func SafeSlice(s string, start, end int) (string, bool) {
+	if start < 0 || end < start || end > len(s) {
+		return "", false
+	}
+	if !isRuneBoundary(s, start) || !isRuneBoundary(s, end) {
+		return "", false
+	}
+
+	return s[start:end], true
+}
+
+// isRuneBoundary reports whether i is the start of a rune in s or the end of s.
+func isRuneBoundary(s string, i int) bool {
+	return i == len(s) || utf8.RuneStart(s[i])
+}
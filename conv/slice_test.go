@@ -0,0 +1,46 @@
+package conv
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSafeSlice(t *testing.T) {
+	s := "aé世🌍b" // a(1) é(2) 世(3) 🌍(4) b(1)
+
+	tests := []struct {
+		start, end int
+		want       string
+		ok         bool
+	}{
+		{0, len(s), s, true},
+		{0, 0, "", true},
+		{len(s), len(s), "", true},
+		{1, 3, "é", true},
+		{3, 6, "世", true},
+		{6, 10, "🌍", true},
+		{1, 10, "é世🌍", true},
+		{2, 3, "", false},  // start inside é
+		{1, 2, "", false},  // end inside é
+		{4, 6, "", false},  // start inside 世
+		{6, 9, "", false},  // end inside 🌍
+		{-1, 1, "", false}, // start out of range
+		{0, 12, "", false}, // end out of range
+		{3, 1, "", false},  // end before start
+	}
+
+	for _, tt := range tests {
+		got, ok := SafeSlice(s, tt.start, tt.end)
+		assert.Equal(t, tt.ok, ok, "unexpected ok for [%d:%d]", tt.start, tt.end)
+		assert.Equal(t, tt.want, got, "unexpected result for [%d:%d]", tt.start, tt.end)
+	}
+}
+
+func TestSafeSlice_NoAllocations(t *testing.T) {
+	s := "héllo, 世界"
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = SafeSlice(s, 1, 3)
+	})
+	assert.Zero(t, allocs, "expected no allocations")
+}
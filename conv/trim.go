@@ -0,0 +1,32 @@
+package conv
+
+// TrimSpaceASCII returns s without leading and trailing ASCII whitespace (space, tab, CR and LF).
+// The result is a view into s and no allocation is made.
+func TrimSpaceASCII(s string) string {
+	start, end := 0, len(s)
+	for start < end && isSpaceASCII(s[start]) {
+		start++
+	}
+	for end > start && isSpaceASCII(s[end-1]) {
+		end--
+	}
+	return s[start:end]
+}
+
+// TrimSpaceASCIIBytes returns b without leading and trailing ASCII whitespace (space, tab, CR and LF).
+// The result is a sub-slice of b sharing its memory; no allocation is made.
+func TrimSpaceASCIIBytes(b []byte) []byte {
+	start, end := 0, len(b)
+	for start < end && isSpaceASCII(b[start]) {
+		start++
+	}
+	for end > start && isSpaceASCII(b[end-1]) {
+		end--
+	}
+	return b[start:end]
+}
+
+// isSpaceASCII reports whether c is a space, tab, CR or LF.
+func isSpaceASCII(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
@@ -0,0 +1,45 @@
+package conv
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"unsafe"
+)
+
+func TestTrimSpaceASCII(t *testing.T) {
+	s := " \t\r\n value with spaces \r\n\t "
+	trimmed := TrimSpaceASCII(s)
+
+	assert.Equal(t, "value with spaces", trimmed, "expected surrounding whitespace to be trimmed")
+	assert.Equal(t, unsafe.StringData(s[5:]), unsafe.StringData(trimmed), "expected shared underlying memory")
+}
+
+func TestTrimSpaceASCIIBytes(t *testing.T) {
+	b := []byte("\r\n\tvalue\t\r\n")
+	trimmed := TrimSpaceASCIIBytes(b)
+
+	assert.Equal(t, []byte("value"), trimmed, "expected surrounding whitespace to be trimmed")
+	assert.Equal(t, unsafe.SliceData(b[3:]), unsafe.SliceData(trimmed), "expected shared underlying memory")
+}
+
+func TestTrimSpaceASCII_AllWhitespace(t *testing.T) {
+	assert.Empty(t, TrimSpaceASCII(" \t\r\n "), "expected empty string for all-whitespace input")
+	assert.Empty(t, TrimSpaceASCIIBytes([]byte(" \t\r\n ")), "expected empty slice for all-whitespace input")
+}
+
+func TestTrimSpaceASCII_EmptyInput(t *testing.T) {
+	assert.Empty(t, TrimSpaceASCII(""), "expected empty string for empty input")
+	assert.Empty(t, TrimSpaceASCIIBytes(nil), "expected empty slice for nil input")
+	assert.Empty(t, TrimSpaceASCIIBytes([]byte{}), "expected empty slice for empty input")
+}
+
+func TestTrimSpaceASCII_NoAllocations(t *testing.T) {
+	s := "  value  "
+	b := []byte(s)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = TrimSpaceASCII(s)
+		_ = TrimSpaceASCIIBytes(b)
+	})
+	assert.Zero(t, allocs, "expected no allocations")
+}
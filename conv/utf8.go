@@ -0,0 +1,35 @@
+package conv
+
+import "unicode/utf8"
+
+// ValidUTF8 reports whether b consists entirely of valid UTF-8-encoded runes, without converting it to a string.
+func ValidUTF8(b []byte) bool {
+	return utf8.Valid(b)
+}
+
+// ValidUTF8String reports whether s consists entirely of valid UTF-8-encoded runes.
+func ValidUTF8String(s string) bool {
+	return utf8.ValidString(s)
+}
+
+// InvalidUTF8Index returns the index of the first byte of b that isn't part of a valid UTF-8 sequence,
+// or -1 if b is valid UTF-8. Use InvalidUTF8Index(StrToBytes(s)) for strings. No allocation is made.
+func InvalidUTF8Index(b []byte) int {
+	if utf8.Valid(b) {
+		return -1
+	}
+
+	for i := 0; i < len(b); {
+		if b[i] < utf8.RuneSelf {
+			i++
+			continue
+		}
+
+		r, size := utf8.DecodeRune(b[i:])
+		if r == utf8.RuneError && size == 1 {
+			return i
+		}
+		i += size
+	}
+	return -1
+}
@@ -0,0 +1,45 @@
+package conv
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestValidUTF8(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		valid   bool
+		invalid int
+	}{
+		{name: "empty", in: "", valid: true, invalid: -1},
+		{name: "ASCII", in: "hello", valid: true, invalid: -1},
+		{name: "multibyte", in: "héllo, 世界 🌍", valid: true, invalid: -1},
+		{name: "encoded replacement character", in: "a�b", valid: true, invalid: -1},
+		{name: "invalid byte", in: "ab\xffcd", valid: false, invalid: 2},
+		{name: "truncated sequence", in: "héllo\xe4\xb8", valid: false, invalid: 6},
+		{name: "invalid after multibyte", in: "世\x80", valid: false, invalid: 3},
+		{name: "surrogate half", in: "a\xed\xa0\x80", valid: false, invalid: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.valid, ValidUTF8([]byte(tt.in)), "unexpected ValidUTF8 result")
+			assert.Equal(t, tt.valid, ValidUTF8String(tt.in), "unexpected ValidUTF8String result")
+			assert.Equal(t, tt.invalid, InvalidUTF8Index([]byte(tt.in)), "unexpected InvalidUTF8Index result")
+		})
+	}
+}
+
+func TestValidUTF8_NoAllocations(t *testing.T) {
+	b := []byte("héllo, 世界\xff")
+	s := "héllo, 世界"
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = ValidUTF8(b)
+		_ = ValidUTF8String(s)
+		_ = InvalidUTF8Index(b)
+		_ = InvalidUTF8Index(StrToBytes(s))
+	})
+	assert.Zero(t, allocs, "expected no allocations")
+}
@@ -0,0 +1,76 @@
+package gin_factory
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireAccept returns a middleware that rejects requests whose Accept header doesn't accept any of
+// the provided media types. Media ranges "*/*" and "type/*" match accordingly, ranges with q=0 are ignored,
+// and the comparison is case-insensitive. Requests without an Accept header accept any type.
+// If no types are provided, "application/json" is required.
+//
+// Mismatching requests are aborted with http.StatusNotAcceptable and a JSON error body.
+func RequireAccept(types ...string) gin.HandlerFunc {
+	if len(types) == 0 {
+		types = []string{"application/json"}
+	}
+
+	supported := make([]string, 0, len(types))
+	for _, t := range types {
+		supported = append(supported, strings.ToLower(strings.TrimSpace(t)))
+	}
+
+	return func(c *gin.Context) {
+		accept := c.Request.Header.Values("Accept")
+		if len(accept) == 0 || acceptsAny(accept, supported) {
+			c.Next()
+			return
+		}
+
+		c.AbortWithStatusJSON(http.StatusNotAcceptable, gin.H{"error": "not acceptable"})
+	}
+}
+
+// acceptsAny reports whether any media range in the Accept header values matches one of the supported types.
+func acceptsAny(accept []string, supported []string) bool {
+	for _, value := range accept {
+		for _, part := range strings.Split(value, ",") {
+			mediaRange, params, err := mime.ParseMediaType(part)
+			if err != nil || isZeroQuality(params["q"]) {
+				continue
+			}
+
+			for _, t := range supported {
+				if mediaRangeMatches(mediaRange, t) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// isZeroQuality reports whether the q parameter of a media range marks it as not acceptable.
+func isZeroQuality(q string) bool {
+	if q == "" {
+		return false
+	}
+
+	v, err := strconv.ParseFloat(q, 64)
+	return err == nil && v == 0
+}
+
+// mediaRangeMatches reports whether mediaRange, possibly with wildcards, matches the media type t.
+func mediaRangeMatches(mediaRange, t string) bool {
+	if mediaRange == "*/*" || mediaRange == t {
+		return true
+	}
+
+	prefix, ok := strings.CutSuffix(mediaRange, "/*")
+	return ok && strings.HasPrefix(t, prefix+"/")
+}
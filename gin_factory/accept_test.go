@@ -0,0 +1,56 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireAccept(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	gf := NewGinFactory()
+	gf.AddMiddleware(RequireAccept("application/json"))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/test", func(c *gin.Context) {
+			c.JSON(http.StatusOK, gin.H{"status": "ok"})
+		})
+	})
+	r := gf.CreateRouter()
+
+	tests := []struct {
+		name   string
+		accept []string
+		status int
+	}{
+		{name: "matching", accept: []string{"Application/JSON; charset=utf-8"}, status: http.StatusOK},
+		{name: "matching in list", accept: []string{"text/html, application/json;q=0.9"}, status: http.StatusOK},
+		{name: "matching in second header", accept: []string{"text/html", "application/json"}, status: http.StatusOK},
+		{name: "wildcard", accept: []string{"*/*"}, status: http.StatusOK},
+		{name: "type wildcard", accept: []string{"application/*"}, status: http.StatusOK},
+		{name: "no header", status: http.StatusOK},
+		{name: "non-matching", accept: []string{"text/html"}, status: http.StatusNotAcceptable},
+		{name: "non-matching type wildcard", accept: []string{"text/*"}, status: http.StatusNotAcceptable},
+		{name: "excluded by quality", accept: []string{"application/json;q=0, text/html"}, status: http.StatusNotAcceptable},
+		{name: "malformed", accept: []string{"json"}, status: http.StatusNotAcceptable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+			for _, v := range tt.accept {
+				req.Header.Add("Accept", v)
+			}
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.status, w.Code, "Unexpected response status")
+			if tt.status == http.StatusNotAcceptable {
+				assert.JSONEq(t, `{"error":"not acceptable"}`, w.Body.String(), "Response body should be a JSON error")
+			}
+		})
+	}
+}
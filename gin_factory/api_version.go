@@ -0,0 +1,67 @@
+package gin_factory
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// apiVersionKey is the gin context key under which APIVersion stores the negotiated version.
+const apiVersionKey = "gin_factory.api_version"
+
+// apiVersionConfig holds the configuration of APIVersion.
+type apiVersionConfig struct {
+	defaultVersion string
+}
+
+// APIVersionOptions represents a configuration option for APIVersion.
+type APIVersionOptions func(cfg *apiVersionConfig)
+
+// WithDefaultVersion makes APIVersion negotiate version for requests without the version header.
+func WithDefaultVersion(version string) APIVersionOptions {
+	return func(cfg *apiVersionConfig) {
+		cfg.defaultVersion = version
+	}
+}
+
+// APIVersion returns a middleware negotiating the API version from the named request header
+// (e.g. "Accept-Version"; "Accept-Version" if headerName is empty) against the supported versions,
+// without versioning URL paths. The negotiated version is stored in the gin context and available
+// via APIVersionFromContext. Requests with an unsupported version are aborted with http.StatusBadRequest,
+// requests without the header with http.StatusNotAcceptable unless a default is set with WithDefaultVersion.
+// Both carry a JSON error.
+func APIVersion(supported []string, headerName string, options ...APIVersionOptions) gin.HandlerFunc {
+	if headerName == "" {
+		headerName = "Accept-Version"
+	}
+	cfg := &apiVersionConfig{}
+	for _, option := range options {
+		option(cfg)
+	}
+	supported = slices.Clone(supported)
+
+	return func(c *gin.Context) {
+		version := strings.TrimSpace(c.GetHeader(headerName))
+		switch {
+		case version == "" && cfg.defaultVersion == "":
+			c.AbortWithStatusJSON(http.StatusNotAcceptable, gin.H{"error": "missing " + headerName + " header"})
+			return
+		case version == "":
+			version = cfg.defaultVersion
+		case !slices.Contains(supported, version):
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "unsupported API version " + version})
+			return
+		}
+
+		c.Set(apiVersionKey, version)
+		c.Next()
+	}
+}
+
+// APIVersionFromContext returns the version negotiated by APIVersion.
+// It returns an empty string if the middleware isn't installed.
+func APIVersionFromContext(c *gin.Context) string {
+	return c.GetString(apiVersionKey)
+}
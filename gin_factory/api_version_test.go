@@ -0,0 +1,74 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIVersion(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(options ...APIVersionOptions) *gin.Engine {
+		gf := NewGinFactory()
+		gf.AddMiddleware(APIVersion([]string{"2024-01-01", "2025-01-01"}, "", options...))
+		gf.AddHandlers(func(r *gin.Engine) {
+			r.GET("/test", func(c *gin.Context) {
+				c.String(http.StatusOK, APIVersionFromContext(c))
+			})
+		})
+		return gf.CreateRouter()
+	}
+
+	tests := []struct {
+		name         string
+		options      []APIVersionOptions
+		header       string
+		expectedCode int
+		expectedBody string
+	}{
+		{"supported version", nil, "2025-01-01", http.StatusOK, "2025-01-01"},
+		{"unsupported version", nil, "2023-01-01", http.StatusBadRequest, `{"error":"unsupported API version 2023-01-01"}`},
+		{"missing header without default", nil, "", http.StatusNotAcceptable, `{"error":"missing Accept-Version header"}`},
+		{"missing header with default", []APIVersionOptions{WithDefaultVersion("2024-01-01")}, "", http.StatusOK, "2024-01-01"},
+		{"header overrides default", []APIVersionOptions{WithDefaultVersion("2024-01-01")}, "2025-01-01", http.StatusOK, "2025-01-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+			if tt.header != "" {
+				req.Header.Set("Accept-Version", tt.header)
+			}
+			newRouter(tt.options...).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Response status should match")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match")
+		})
+	}
+}
+
+func TestAPIVersionCustomHeader(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	gf := NewGinFactory()
+	gf.AddMiddleware(APIVersion([]string{"v2"}, "X-API-Version"))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/test", func(c *gin.Context) {
+			c.String(http.StatusOK, APIVersionFromContext(c))
+		})
+	})
+	r := gf.CreateRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+	req.Header.Set("X-API-Version", "v2")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code, "Response status should be OK")
+	assert.Equal(t, "v2", w.Body.String(), "Version should be read from the custom header")
+}
@@ -0,0 +1,13 @@
+package gin_factory
+
+import "strings"
+
+// WithBasePath makes CreateRouter register every route added with AddHandlers (and helpers built on it,
+// such as AddPprof) under prefix, e.g. "/api/v1", so handlers can register bare paths.
+// Middleware, the 404 and 405 handlers and routes registered on the router after CreateRouter are unaffected.
+// An empty prefix or "/" disables the base path.
+func WithBasePath(prefix string) FactoryOptions {
+	return func(g *GinFactory) {
+		g.basePath = strings.TrimSuffix("/"+strings.Trim(prefix, "/"), "/")
+	}
+}
@@ -0,0 +1,78 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithBasePath(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	gf := NewGinFactory(WithBasePath("api/v1/"), WithAutoOptions())
+	gf.AddMiddleware(func(c *gin.Context) {
+		c.Header("X-Middleware", "ran")
+	})
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/users", func(c *gin.Context) {
+			c.String(http.StatusOK, c.FullPath())
+		})
+		admin := r.Group("/admin")
+		admin.GET("/stats", func(c *gin.Context) {
+			c.String(http.StatusOK, c.FullPath())
+		})
+	})
+	r := gf.CreateRouter()
+	r.GET("/healthz", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		expectedCode int
+		expectedBody string
+	}{
+		{"prefixed route", http.MethodGet, "/api/v1/users", http.StatusOK, "/api/v1/users"},
+		{"prefixed group route", http.MethodGet, "/api/v1/admin/stats", http.StatusOK, "/api/v1/admin/stats"},
+		{"bare route", http.MethodGet, "/users", http.StatusNotFound, "404 page not found"},
+		{"auto options", http.MethodOptions, "/api/v1/users", http.StatusNoContent, ""},
+		{"route added after CreateRouter", http.MethodGet, "/healthz", http.StatusOK, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tt.method, tt.path, nil)
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Response status should match")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match")
+			assert.Equal(t, "ran", w.Header().Get("X-Middleware"), "Middleware should run for every request")
+		})
+	}
+}
+
+func TestWithBasePathDisabled(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	for _, prefix := range []string{"", "/"} {
+		gf := NewGinFactory(WithBasePath(prefix))
+		gf.AddHandlers(func(r *gin.Engine) {
+			r.GET("/users", func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+		})
+		r := gf.CreateRouter()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/users", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, "Routes should not be prefixed for %q", prefix)
+	}
+}
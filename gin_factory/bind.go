@@ -0,0 +1,25 @@
+package gin_factory
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BindQuery binds the query parameters of the request into a new value of type T
+// using gin's query binding and validator (`form` and `binding` struct tags).
+// On failure, it aborts the request with http.StatusBadRequest and a JSON error and returns false,
+// so handlers can return early:
+//
+//	q, ok := gin_factory.BindQuery[ListQuery](c)
+//	if !ok {
+//	    return
+//	}
+func BindQuery[T any](c *gin.Context) (T, bool) {
+	var v T
+	if err := c.ShouldBindQuery(&v); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return v, false
+	}
+	return v, true
+}
@@ -0,0 +1,77 @@
+package gin_factory
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testQuery struct {
+	Name  string `form:"name" binding:"required"`
+	Limit int    `form:"limit" binding:"omitempty,gte=1,lte=100"`
+}
+
+func newBindQueryRouter(handlerCalled *bool) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	gf := NewGinFactory()
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/test", func(c *gin.Context) {
+			q, ok := BindQuery[testQuery](c)
+			if !ok {
+				return
+			}
+			*handlerCalled = true
+			c.JSON(http.StatusOK, q)
+		})
+	})
+
+	return gf.CreateRouter()
+}
+
+func TestBindQuery(t *testing.T) {
+	t.Run("valid query", func(t *testing.T) {
+		handlerCalled := false
+		r := newBindQueryRouter(&handlerCalled)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/test?name=gopher&limit=10", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, "Valid query should pass")
+		assert.True(t, handlerCalled, "Handler should continue after successful binding")
+		assert.JSONEq(t, `{"Name":"gopher","Limit":10}`, w.Body.String(), "Query should be bound into the struct")
+	})
+
+	t.Run("missing required field", func(t *testing.T) {
+		handlerCalled := false
+		r := newBindQueryRouter(&handlerCalled)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/test?limit=10", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, "Missing required field should return 400")
+		assert.False(t, handlerCalled, "Handler should return early on binding failure")
+
+		var body map[string]string
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Contains(t, body["error"], "Name", "Error should mention the missing field")
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+		handlerCalled := false
+		r := newBindQueryRouter(&handlerCalled)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/test?name=gopher&limit=ten", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, "Type mismatch should return 400")
+		assert.False(t, handlerCalled, "Handler should return early on binding failure")
+	})
+}
@@ -0,0 +1,33 @@
+package gin_factory
+
+import (
+	"crypto/x509"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireClientCert returns a middleware requiring a TLS client certificate, e.g. for mutual TLS between services.
+// The leaf certificate from c.Request.TLS.PeerCertificates is passed to verify, which returns an error to reject it,
+// e.g. when its SANs don't match the expected service names. A nil verify only requires a certificate to be present.
+//
+// Chain verification is up to the TLS server configuration (tls.Config.ClientAuth and ClientCAs);
+// the middleware only inspects the certificate the server accepted.
+// Requests without a certificate or with a rejected one are aborted with http.StatusUnauthorized and a JSON error body.
+func RequireClientCert(verify func(cert *x509.Certificate) error) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.TLS == nil || len(c.Request.TLS.PeerCertificates) == 0 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "client certificate required"})
+			return
+		}
+
+		if verify != nil {
+			if err := verify(c.Request.TLS.PeerCertificates[0]); err != nil {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "client certificate rejected"})
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,69 @@
+package gin_factory
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireClientCert(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	verify := func(cert *x509.Certificate) error {
+		if !slices.Contains(cert.DNSNames, "billing.internal") {
+			return errors.New("unexpected SAN")
+		}
+		return nil
+	}
+
+	gf := NewGinFactory()
+	gf.AddMiddleware(RequireClientCert(verify))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/test", func(c *gin.Context) {
+			c.String(http.StatusOK, "test handler")
+		})
+	})
+	r := gf.CreateRouter()
+
+	serve := func(state *tls.ConnectionState) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		req.TLS = state
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("valid certificate", func(t *testing.T) {
+		w := serve(&tls.ConnectionState{PeerCertificates: []*x509.Certificate{{DNSNames: []string{"billing.internal"}}}})
+
+		assert.Equal(t, http.StatusOK, w.Code, "Valid certificate should pass")
+		assert.Equal(t, "test handler", w.Body.String(), "Response body should match handler output")
+	})
+
+	t.Run("rejected certificate", func(t *testing.T) {
+		w := serve(&tls.ConnectionState{PeerCertificates: []*x509.Certificate{{DNSNames: []string{"other.internal"}}}})
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code, "Rejected certificate should be unauthorized")
+		assert.JSONEq(t, `{"error":"client certificate rejected"}`, w.Body.String(), "Response body should be a JSON error")
+	})
+
+	t.Run("no certificate", func(t *testing.T) {
+		w := serve(&tls.ConnectionState{})
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code, "Missing certificate should be unauthorized")
+		assert.JSONEq(t, `{"error":"client certificate required"}`, w.Body.String(), "Response body should be a JSON error")
+	})
+
+	t.Run("plaintext", func(t *testing.T) {
+		w := serve(nil)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code, "Plaintext request should be unauthorized")
+	})
+}
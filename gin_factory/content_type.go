@@ -0,0 +1,49 @@
+package gin_factory
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireContentType returns a middleware that rejects write requests (POST, PUT, PATCH)
+// whose Content-Type header doesn't match any of the provided media types.
+// Parameters such as charset are ignored and the comparison is case-insensitive.
+// If no types are provided, "application/json" is required.
+//
+// Mismatching requests are aborted with http.StatusUnsupportedMediaType and a JSON error body.
+// Requests with other methods pass through unchecked.
+func RequireContentType(types ...string) gin.HandlerFunc {
+	if len(types) == 0 {
+		types = []string{"application/json"}
+	}
+
+	allowed := make(map[string]struct{}, len(types))
+	for _, t := range types {
+		allowed[strings.ToLower(strings.TrimSpace(t))] = struct{}{}
+	}
+
+	return func(c *gin.Context) {
+		if !isWriteMethod(c.Request.Method) {
+			c.Next()
+			return
+		}
+
+		mediaType, _, err := mime.ParseMediaType(c.GetHeader("Content-Type"))
+		if err == nil {
+			if _, ok := allowed[mediaType]; ok {
+				c.Next()
+				return
+			}
+		}
+
+		c.AbortWithStatusJSON(http.StatusUnsupportedMediaType, gin.H{"error": "unsupported content type"})
+	}
+}
+
+// isWriteMethod reports whether the HTTP method is expected to carry a request body.
+func isWriteMethod(method string) bool {
+	return method == http.MethodPost || method == http.MethodPut || method == http.MethodPatch
+}
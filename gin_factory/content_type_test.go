@@ -0,0 +1,73 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newContentTypeRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	gf := NewGinFactory()
+	gf.AddMiddleware(RequireContentType("application/json"))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.POST("/test", func(c *gin.Context) {
+			c.String(http.StatusOK, "test handler")
+		})
+		r.GET("/test", func(c *gin.Context) {
+			c.String(http.StatusOK, "test handler")
+		})
+	})
+
+	return gf.CreateRouter()
+}
+
+func TestRequireContentType(t *testing.T) {
+	t.Run("matching type", func(t *testing.T) {
+		r := newContentTypeRouter()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/test", strings.NewReader(`{}`))
+		req.Header.Set("Content-Type", "Application/JSON; charset=utf-8")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, "Matching content type should pass")
+		assert.Equal(t, "test handler", w.Body.String(), "Response body should match handler output")
+	})
+
+	t.Run("mismatching type", func(t *testing.T) {
+		r := newContentTypeRouter()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/test", strings.NewReader(`a=b`))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code, "Mismatching content type should return 415")
+		assert.JSONEq(t, `{"error":"unsupported content type"}`, w.Body.String(), "Response body should be a JSON error")
+	})
+
+	t.Run("missing type", func(t *testing.T) {
+		r := newContentTypeRouter()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/test", strings.NewReader(`{}`))
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code, "Missing content type should return 415")
+	})
+
+	t.Run("bodyless GET", func(t *testing.T) {
+		r := newContentTypeRouter()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, "GET without body should pass")
+	})
+}
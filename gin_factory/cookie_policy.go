@@ -0,0 +1,105 @@
+package gin_factory
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CookiePolicyConfig configures the attributes CookiePolicy enforces on response cookies.
+type CookiePolicyConfig struct {
+	// Secure sets the Secure attribute on every cookie.
+	Secure bool
+	// HTTPOnly sets the HttpOnly attribute on every cookie.
+	HTTPOnly bool
+	// SameSite is set on cookies without a SameSite attribute. The zero value leaves them as is.
+	SameSite http.SameSite
+}
+
+// CookiePolicy returns a middleware enforcing cfg on the Set-Cookie headers of the response right before
+// it is sent, after the handlers and other middleware had the chance to set them. Cookies set several times
+// with the same name, path and domain are collapsed into the last one. Cookies with SameSite=None are always
+// made Secure, as browsers require. Missing attributes are appended to the Set-Cookie value, which is otherwise
+// kept as is, including attributes net/http doesn't know, such as Priority. Values that can't be parsed are kept.
+func CookiePolicy(cfg CookiePolicyConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		withBeforeHeaders(c, func(w gin.ResponseWriter) {
+			lines := w.Header().Values("Set-Cookie")
+			if len(lines) == 0 {
+				return
+			}
+			w.Header()["Set-Cookie"] = applyCookiePolicy(cfg, lines)
+		})
+	}
+}
+
+// cookieIdentity identifies a cookie in the browser cookie store.
+type cookieIdentity struct {
+	name, path, domain string
+}
+
+// applyCookiePolicy returns the Set-Cookie values with cfg enforced and duplicates removed,
+// keeping the position of the last occurrence of each cookie.
+func applyCookiePolicy(cfg CookiePolicyConfig, lines []string) []string {
+	out := make([]string, 0, len(lines))
+	last := make(map[cookieIdentity]int, len(lines))
+
+	for _, line := range lines {
+		cookie, err := http.ParseSetCookie(line)
+		if err != nil {
+			out = append(out, line)
+			continue
+		}
+
+		// The cookie is only parsed to inspect it: serializing it again would drop the attributes
+		// net/http doesn't model, so the missing attributes are appended to the original line instead.
+		sameSite := cookie.SameSite
+		if sameSite == 0 {
+			sameSite = cfg.SameSite
+		}
+
+		var missing string
+		if cfg.HTTPOnly && !cookie.HttpOnly {
+			missing += "; HttpOnly"
+		}
+		if (cfg.Secure || sameSite == http.SameSiteNoneMode) && !cookie.Secure {
+			missing += "; Secure"
+		}
+		if sameSite != cookie.SameSite {
+			missing += sameSiteAttr(sameSite)
+		}
+		if missing != "" {
+			line = strings.TrimRight(line, "; ") + missing
+		}
+
+		id := cookieIdentity{name: cookie.Name, path: cookie.Path, domain: cookie.Domain}
+		if i, ok := last[id]; ok {
+			out[i] = ""
+		}
+		last[id] = len(out)
+		out = append(out, line)
+	}
+
+	deduplicated := out[:0]
+	for _, line := range out {
+		if line != "" {
+			deduplicated = append(deduplicated, line)
+		}
+	}
+	return deduplicated
+}
+
+// sameSiteAttr returns the Set-Cookie attribute for mode, prefixed with its separator.
+func sameSiteAttr(mode http.SameSite) string {
+	switch mode {
+	case http.SameSiteLaxMode:
+		return "; SameSite=Lax"
+	case http.SameSiteStrictMode:
+		return "; SameSite=Strict"
+	case http.SameSiteNoneMode:
+		return "; SameSite=None"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,100 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCookiePolicy(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	gf := NewGinFactory()
+	gf.AddMiddleware(CookiePolicy(CookiePolicyConfig{Secure: true, HTTPOnly: true, SameSite: http.SameSiteLaxMode}))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/login", func(c *gin.Context) {
+			c.SetCookie("session", "abc", 3600, "/", "", false, false)
+			c.String(http.StatusOK, "ok")
+		})
+		r.GET("/duplicates", func(c *gin.Context) {
+			c.SetCookie("theme", "light", 0, "/", "", false, false)
+			c.SetCookie("lang", "en", 0, "/", "", false, false)
+			c.SetCookie("theme", "dark", 0, "/", "", false, false)
+			c.SetCookie("theme", "blue", 0, "/admin", "", false, false)
+			c.Status(http.StatusOK)
+		})
+		r.GET("/strict", func(c *gin.Context) {
+			c.SetSameSite(http.SameSiteStrictMode)
+			c.SetCookie("csrf", "token", 0, "/", "", false, false)
+			c.Status(http.StatusOK)
+		})
+		r.GET("/priority", func(c *gin.Context) {
+			c.Writer.Header().Add("Set-Cookie", "pref=1; Path=/; Priority=High; Partitioned")
+			c.Status(http.StatusOK)
+		})
+		r.GET("/hardened", func(c *gin.Context) {
+			c.Writer.Header().Add("Set-Cookie", "id=1; Secure; HttpOnly; SameSite=Lax")
+			c.Status(http.StatusOK)
+		})
+		r.GET("/invalid", func(c *gin.Context) {
+			c.Writer.Header().Add("Set-Cookie", "=invalid")
+			c.Status(http.StatusOK)
+		})
+		r.GET("/none", func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+	})
+	r := gf.CreateRouter()
+
+	tests := []struct {
+		name            string
+		path            string
+		expectedCookies []string
+	}{
+		{"hardened cookie", "/login", []string{"session=abc; Path=/; Max-Age=3600; HttpOnly; Secure; SameSite=Lax"}},
+		{"duplicates", "/duplicates", []string{
+			"lang=en; Path=/; HttpOnly; Secure; SameSite=Lax",
+			"theme=dark; Path=/; HttpOnly; Secure; SameSite=Lax",
+			"theme=blue; Path=/admin; HttpOnly; Secure; SameSite=Lax",
+		}},
+		{"explicit SameSite kept", "/strict", []string{"csrf=token; Path=/; SameSite=Strict; HttpOnly; Secure"}},
+		{"unknown attributes kept", "/priority", []string{"pref=1; Path=/; Priority=High; Partitioned; HttpOnly; Secure; SameSite=Lax"}},
+		{"present attributes not repeated", "/hardened", []string{"id=1; Secure; HttpOnly; SameSite=Lax"}},
+		{"unparseable cookie kept", "/invalid", []string{"=invalid"}},
+		{"no cookies", "/none", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code, "Response status should be OK")
+			assert.Equal(t, tt.expectedCookies, w.Header().Values("Set-Cookie"), "Cookies should follow the policy")
+		})
+	}
+}
+
+func TestCookiePolicySameSiteNone(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	gf := NewGinFactory()
+	gf.AddMiddleware(CookiePolicy(CookiePolicyConfig{SameSite: http.SameSiteNoneMode}))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/embed", func(c *gin.Context) {
+			c.SetCookie("widget", "1", 0, "/", "", false, false)
+			c.Status(http.StatusOK)
+		})
+	})
+	r := gf.CreateRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/embed", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, []string{"widget=1; Path=/; Secure; SameSite=None"}, w.Header().Values("Set-Cookie"), "SameSite=None cookies should be Secure")
+}
@@ -0,0 +1,50 @@
+package gin_factory
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DeadlineFromHeader returns a middleware bounding the request context with the deadline the client
+// announces in the named header (e.g. "X-Timeout-Ms") as a positive number of milliseconds, capped at maxTimeout,
+// so handlers stop working on requests the client has abandoned. Requests without the header get
+// a deadline of maxTimeout. Malformed values are rejected with http.StatusBadRequest and a JSON error.
+// A maxTimeout of 0 or below disables the cap. As with Timeout, handlers observe the deadline through
+// c.Request.Context() and the middleware doesn't interrupt them.
+func DeadlineFromHeader(headerName string, maxTimeout time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		d := maxTimeout
+
+		if value := c.GetHeader(headerName); value != "" {
+			ms, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || ms <= 0 {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid " + headerName + " header"})
+				return
+			}
+
+			requested := time.Duration(math.MaxInt64)
+			if ms < math.MaxInt64/int64(time.Millisecond) {
+				requested = time.Duration(ms) * time.Millisecond
+			}
+			if maxTimeout <= 0 || requested < maxTimeout {
+				d = requested
+			}
+		}
+
+		if d <= 0 {
+			c.Next()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), d)
+		defer cancel()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
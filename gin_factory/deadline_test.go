@@ -0,0 +1,71 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeadlineFromHeader(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(max time.Duration) (*gin.Engine, *time.Duration) {
+		remaining := new(time.Duration)
+		gf := NewGinFactory()
+		gf.AddMiddleware(DeadlineFromHeader("X-Timeout-Ms", max))
+		gf.AddHandlers(func(r *gin.Engine) {
+			r.GET("/test", func(c *gin.Context) {
+				*remaining = -1
+				if deadline, ok := c.Request.Context().Deadline(); ok {
+					*remaining = time.Until(deadline)
+				}
+				c.Status(http.StatusOK)
+			})
+		})
+		return gf.CreateRouter(), remaining
+	}
+
+	tests := []struct {
+		name         string
+		max          time.Duration
+		header       string
+		expectedCode int
+		minRemaining time.Duration
+		maxRemaining time.Duration
+	}{
+		{"valid header", time.Minute, "500", http.StatusOK, 400 * time.Millisecond, 500 * time.Millisecond},
+		{"value exceeding max", time.Second, "60000", http.StatusOK, 900 * time.Millisecond, time.Second},
+		{"huge value", time.Second, "9223372036854775807", http.StatusOK, 900 * time.Millisecond, time.Second},
+		{"missing header", time.Second, "", http.StatusOK, 900 * time.Millisecond, time.Second},
+		{"no cap", 0, "2000", http.StatusOK, 1900 * time.Millisecond, 2 * time.Second},
+		{"no cap and no header", 0, "", http.StatusOK, -1, -1},
+		{"malformed value", time.Second, "soon", http.StatusBadRequest, 0, 0},
+		{"zero value", time.Second, "0", http.StatusBadRequest, 0, 0},
+		{"negative value", time.Second, "-5", http.StatusBadRequest, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, remaining := newRouter(tt.max)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+			if tt.header != "" {
+				req.Header.Set("X-Timeout-Ms", tt.header)
+			}
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Response status should match")
+			if tt.expectedCode != http.StatusOK {
+				assert.JSONEq(t, `{"error":"invalid X-Timeout-Ms header"}`, w.Body.String(), "Response body should describe the error")
+				return
+			}
+			assert.GreaterOrEqual(t, *remaining, tt.minRemaining, "Deadline should match the expected duration")
+			assert.LessOrEqual(t, *remaining, tt.maxRemaining, "Deadline should match the expected duration")
+		})
+	}
+}
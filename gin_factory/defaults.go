@@ -0,0 +1,33 @@
+package gin_factory
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// DefaultStackConfig selects the components of the middleware stack assembled by NewGinFactoryWithDefaults.
+// The zero value disables every component.
+type DefaultStackConfig struct {
+	// Recovery enables the recovery middleware, see NewGinFactory.
+	Recovery bool
+}
+
+// DefaultStack returns the recommended DefaultStackConfig with every component enabled.
+func DefaultStack() DefaultStackConfig {
+	return DefaultStackConfig{
+		Recovery: true,
+	}
+}
+
+// NewGinFactoryWithDefaults initializes a new instance of GinFactory with the middleware stack selected by cfg.
+// The stack is limited to the middleware the package provides. Request IDs, request logging, metrics and
+// timeouts depend on the libraries and conventions of the service, so their middleware is added with AddMiddleware.
+// Provided options are applied as in NewGinFactory. Further middleware can be added with AddMiddleware.
+func NewGinFactoryWithDefaults(cfg DefaultStackConfig, options ...FactoryOptions) *GinFactory {
+	g := NewGinFactory(options...)
+
+	if !cfg.Recovery {
+		g.middleware = make([]gin.HandlerFunc, 0)
+	}
+
+	return g
+}
@@ -0,0 +1,49 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGinFactoryWithDefaults(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	serve := func(cfg DefaultStackConfig, path string) *httptest.ResponseRecorder {
+		gf := NewGinFactoryWithDefaults(cfg)
+		gf.AddHandlers(func(r *gin.Engine) {
+			r.GET("/test", func(c *gin.Context) {
+				c.String(http.StatusOK, "test handler")
+			})
+			r.GET("/panic", func(c *gin.Context) {
+				panic("boom")
+			})
+		})
+		r := gf.CreateRouter()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, path, nil)
+		r.ServeHTTP(w, req)
+
+		return w
+	}
+
+	t.Run("all enabled", func(t *testing.T) {
+		w := serve(DefaultStack(), "/test")
+		assert.Equal(t, http.StatusOK, w.Code, "Response status should be OK")
+		assert.Empty(t, w.Header().Get("Server-Timing"), "Server timing should not be part of the stack")
+
+		w = serve(DefaultStack(), "/panic")
+		assert.Equal(t, http.StatusInternalServerError, w.Code, "Panic should be recovered")
+	})
+
+	t.Run("all disabled", func(t *testing.T) {
+		w := serve(DefaultStackConfig{}, "/test")
+		assert.Equal(t, http.StatusOK, w.Code, "Response status should be OK")
+
+		assert.Panics(t, func() { serve(DefaultStackConfig{}, "/panic") }, "Panic should not be recovered")
+	})
+}
@@ -0,0 +1,43 @@
+package gin_factory
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
+
+// clientDoneKey is the gin context key under which ClientDisconnect stores the disconnect channel.
+const clientDoneKey = "gin_factory.client_done"
+
+// ClientDisconnect returns a middleware binding the lifetime of c.Request.Context() to the client connection:
+// the context is cancelled when the client disconnects, as reported by the server, or when the handler chain returns,
+// so goroutines spawned by handlers don't outlive the request. Use WaitForClient to obtain the done channel.
+func ClientDisconnect() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithCancel(c.Request.Context())
+		defer cancel()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Set(clientDoneKey, ctx.Done())
+
+		c.Next()
+	}
+}
+
+// WaitForClient returns a channel closed when the client disconnects. If ClientDisconnect is installed,
+// the channel is also closed once the request completes. Handlers select on it to abort long-running work:
+//
+//	select {
+//	case <-gin_factory.WaitForClient(c):
+//	    return
+//	case res := <-work:
+//	    c.JSON(http.StatusOK, res)
+//	}
+func WaitForClient(c *gin.Context) <-chan struct{} {
+	if v, ok := c.Get(clientDoneKey); ok {
+		if done, ok := v.(<-chan struct{}); ok {
+			return done
+		}
+	}
+	return c.Request.Context().Done()
+}
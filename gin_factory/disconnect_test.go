@@ -0,0 +1,105 @@
+package gin_factory
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientDisconnect(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("disconnect stops work", func(t *testing.T) {
+		var (
+			steps   atomic.Int32
+			stopped = make(chan struct{})
+		)
+
+		gf := NewGinFactory()
+		gf.AddMiddleware(ClientDisconnect())
+		gf.AddHandlers(func(r *gin.Engine) {
+			r.GET("/work", func(c *gin.Context) {
+				defer close(stopped)
+
+				ticker := time.NewTicker(time.Millisecond)
+				defer ticker.Stop()
+
+				for {
+					select {
+					case <-WaitForClient(c):
+						return
+					case <-ticker.C:
+						steps.Add(1)
+					}
+				}
+			})
+		})
+		r := gf.CreateRouter()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "/work", nil)
+
+		go r.ServeHTTP(httptest.NewRecorder(), req)
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+
+		select {
+		case <-stopped:
+		case <-time.After(time.Second):
+			t.Fatal("Work should stop after the client disconnects")
+		}
+
+		done := steps.Load()
+		time.Sleep(10 * time.Millisecond)
+		assert.Equal(t, done, steps.Load(), "No work should happen after the disconnect")
+	})
+
+	t.Run("spawned work stops when request completes", func(t *testing.T) {
+		stopped := make(chan struct{})
+
+		gf := NewGinFactory()
+		gf.AddMiddleware(ClientDisconnect())
+		gf.AddHandlers(func(r *gin.Engine) {
+			r.GET("/spawn", func(c *gin.Context) {
+				done := WaitForClient(c)
+				go func() {
+					<-done
+					close(stopped)
+				}()
+				c.Status(http.StatusOK)
+			})
+		})
+		r := gf.CreateRouter()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/spawn", nil)
+		r.ServeHTTP(w, req)
+
+		select {
+		case <-stopped:
+		case <-time.After(time.Second):
+			t.Fatal("Spawned work should stop once the request completes")
+		}
+	})
+
+	t.Run("without middleware", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request, _ = http.NewRequestWithContext(ctx, http.MethodGet, "/", nil)
+
+		done := WaitForClient(c)
+		cancel()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("Channel should be closed when the request context is cancelled")
+		}
+	})
+}
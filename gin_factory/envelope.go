@@ -0,0 +1,98 @@
+package gin_factory
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// WithResponseEnvelope makes CreateRouter transform the body of every JSON response with fn before it is
+// sent, e.g. to wrap it in {"data": ...} along with the request ID, without handlers knowing about it.
+// Responses are buffered to be transformed; responses with a non-JSON content type and streamed responses
+// (flushed by the handler) are sent as is. If fn returns nil, the original body is sent, which lets fn skip
+// responses such as errors based on c.Writer.Status(). The transformation runs after all middleware,
+// right before the route handlers, so middleware observes the transformed body.
+func WithResponseEnvelope(fn func(c *gin.Context, body []byte) []byte) FactoryOptions {
+	return func(g *GinFactory) {
+		g.envelope = fn
+	}
+}
+
+// responseEnvelope returns the middleware applying the WithResponseEnvelope transformation.
+func responseEnvelope(fn func(c *gin.Context, body []byte) []byte) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		w := &envelopeWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+		defer func() { c.Writer = w.ResponseWriter }()
+
+		c.Next()
+
+		if w.streaming {
+			return
+		}
+
+		body := w.body.Bytes()
+		if w.body.Len() > 0 && isJSONContentType(w.Header().Get("Content-Type")) {
+			if transformed := fn(c, body); transformed != nil {
+				body = transformed
+				if w.Header().Get("Content-Length") != "" {
+					w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+				}
+			}
+		}
+		if len(body) == 0 {
+			if w.pending {
+				w.ResponseWriter.WriteHeaderNow()
+			}
+			return
+		}
+		_, _ = w.ResponseWriter.Write(body)
+	}
+}
+
+// envelopeWriter is a gin.ResponseWriter buffering the response body until the handler chain returns.
+// Once flushed, it stops buffering and passes writes through.
+type envelopeWriter struct {
+	gin.ResponseWriter
+	body      bytes.Buffer
+	pending   bool
+	streaming bool
+}
+
+func (w *envelopeWriter) Written() bool {
+	return w.ResponseWriter.Written() || w.pending || w.body.Len() > 0
+}
+
+func (w *envelopeWriter) WriteHeaderNow() {
+	if w.streaming {
+		w.ResponseWriter.WriteHeaderNow()
+		return
+	}
+	w.pending = true
+}
+
+func (w *envelopeWriter) Write(data []byte) (int, error) {
+	if w.streaming {
+		return w.ResponseWriter.Write(data)
+	}
+	return w.body.Write(data)
+}
+
+func (w *envelopeWriter) WriteString(s string) (int, error) {
+	if w.streaming {
+		return w.ResponseWriter.WriteString(s)
+	}
+	return w.body.WriteString(s)
+}
+
+func (w *envelopeWriter) Flush() {
+	if !w.streaming {
+		w.streaming = true
+		if w.body.Len() > 0 {
+			_, _ = w.ResponseWriter.Write(w.body.Bytes())
+			w.body.Reset()
+		}
+	}
+	w.ResponseWriter.Flush()
+}
@@ -0,0 +1,90 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithResponseEnvelope(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	gf := NewGinFactory(WithResponseEnvelope(func(c *gin.Context, body []byte) []byte {
+		if c.Writer.Status() >= http.StatusBadRequest {
+			return nil
+		}
+		return append(append([]byte(`{"data":`), body...), '}')
+	}))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/json", func(c *gin.Context) {
+			c.JSON(http.StatusOK, gin.H{"x": 1})
+		})
+		r.GET("/text", func(c *gin.Context) {
+			c.String(http.StatusOK, "plain")
+		})
+		r.GET("/error", func(c *gin.Context) {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "not found"})
+		})
+		r.GET("/empty", func(c *gin.Context) {
+			c.Status(http.StatusNoContent)
+		})
+		r.GET("/stream", func(c *gin.Context) {
+			c.Header("Content-Type", "application/json")
+			_, _ = c.Writer.WriteString(`{"part":1}`)
+			c.Writer.Flush()
+			_, _ = c.Writer.WriteString(`{"part":2}`)
+		})
+	})
+	r := gf.CreateRouter()
+
+	tests := []struct {
+		name         string
+		path         string
+		expectedCode int
+		expectedBody string
+	}{
+		{"json response", "/json", http.StatusOK, `{"data":{"x":1}}`},
+		{"non-json response", "/text", http.StatusOK, "plain"},
+		{"skipped error response", "/error", http.StatusNotFound, `{"error":"not found"}`},
+		{"empty response", "/empty", http.StatusNoContent, ""},
+		{"streamed response", "/stream", http.StatusOK, `{"part":1}{"part":2}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Response status should match")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match")
+		})
+	}
+}
+
+func TestWithResponseEnvelopeRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	gf := NewGinFactory(WithResponseEnvelope(func(c *gin.Context, body []byte) []byte {
+		return []byte(`{"data":` + string(body) + `,"request_id":"` + c.GetString("request_id") + `"}`)
+	}))
+	gf.AddMiddleware(func(c *gin.Context) {
+		c.Set("request_id", c.GetHeader("X-Request-ID"))
+	})
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/json", func(c *gin.Context) {
+			c.JSON(http.StatusOK, gin.H{"x": 1})
+		})
+	})
+	r := gf.CreateRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/json", nil)
+	req.Header.Set("X-Request-ID", "abc-123")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, `{"data":{"x":1},"request_id":"abc-123"}`, w.Body.String(), "Envelope should carry the request ID")
+}
@@ -0,0 +1,47 @@
+package gin_factory
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetErrorMapper sets the function HandlerE uses to convert errors returned by handlers into a status code
+// and a JSON response body, so the error taxonomy (e.g. not found → 404, validation → 400) is registered once
+// for the whole factory. A nil mapper responds with http.StatusInternalServerError and a generic body.
+func (g *GinFactory) SetErrorMapper(fn func(err error) (int, any)) {
+	g.errorMapper = fn
+}
+
+// HandlerE adapts a handler returning an error into a gin.HandlerFunc. A non-nil error aborts the request
+// with the status and body returned by the error mapper set with SetErrorMapper.
+// The mapper is read when the request is handled, so SetErrorMapper may be called after HandlerE.
+//
+// Example usage:
+//
+//	gf.AddHandlers(func(r *gin.Engine) {
+//	    r.GET("/users/:id", gf.HandlerE(func(c *gin.Context) error {
+//	        user, err := store.Get(c.Param("id"))
+//	        if err != nil {
+//	            return err
+//	        }
+//	        c.JSON(http.StatusOK, user)
+//	        return nil
+//	    }))
+//	})
+func (g *GinFactory) HandlerE(fn func(c *gin.Context) error) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		err := fn(c)
+		if err == nil {
+			return
+		}
+
+		if g.errorMapper == nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+			return
+		}
+
+		status, body := g.errorMapper(err)
+		c.AbortWithStatusJSON(status, body)
+	}
+}
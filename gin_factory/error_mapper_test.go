@@ -0,0 +1,92 @@
+package gin_factory
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+var errNotFound = errors.New("not found")
+
+type validationError struct {
+	field string
+}
+
+func (e *validationError) Error() string {
+	return "invalid " + e.field
+}
+
+func TestHandlerE(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	gf := NewGinFactory()
+	gf.SetErrorMapper(func(err error) (int, any) {
+		var vErr *validationError
+		switch {
+		case errors.Is(err, errNotFound):
+			return http.StatusNotFound, gin.H{"error": "resource not found"}
+		case errors.As(err, &vErr):
+			return http.StatusBadRequest, gin.H{"error": "validation failed", "field": vErr.field}
+		default:
+			return http.StatusInternalServerError, gin.H{"error": "unexpected"}
+		}
+	})
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/missing", gf.HandlerE(func(c *gin.Context) error {
+			return errNotFound
+		}))
+		r.GET("/invalid", gf.HandlerE(func(c *gin.Context) error {
+			return &validationError{field: "name"}
+		}))
+		r.GET("/ok", gf.HandlerE(func(c *gin.Context) error {
+			c.String(http.StatusOK, "ok")
+			return nil
+		}))
+	})
+	r := gf.CreateRouter()
+
+	tests := []struct {
+		name         string
+		path         string
+		expectedCode int
+		expectedBody string
+	}{
+		{"not found error", "/missing", http.StatusNotFound, `{"error":"resource not found"}`},
+		{"validation error", "/invalid", http.StatusBadRequest, `{"error":"validation failed","field":"name"}`},
+		{"no error", "/ok", http.StatusOK, "ok"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Response status should match the mapped status")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match the mapped body")
+		})
+	}
+}
+
+func TestHandlerENilMapper(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	gf := NewGinFactory()
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/fail", gf.HandlerE(func(c *gin.Context) error {
+			return errNotFound
+		}))
+	})
+	r := gf.CreateRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/fail", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code, "Nil mapper should respond with 500")
+	assert.Equal(t, `{"error":"internal server error"}`, w.Body.String(), "Nil mapper should respond with a generic body")
+}
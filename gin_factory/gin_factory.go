@@ -2,19 +2,52 @@
 // It simplifies the creation of a Gin router with preconfigured middleware and route handlers.
 package gin_factory
 
-import "github.com/gin-gonic/gin"
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+)
 
 // GinFactory is a factory for managing middleware and handlers in a Gin application.
 // It provides methods for adding middleware, adding handlers, and creating a router instance.
 type GinFactory struct {
-	middleware []gin.HandlerFunc
-	handlers   []func(router *gin.Engine)
+	middleware   []gin.HandlerFunc
+	routeWrapper []gin.HandlerFunc
+	handlers     []func(router *gin.Engine)
+	panicHook    func(c *gin.Context, recovered any)
+	autoOptions  bool
+	errorMapper  func(err error) (int, any)
+	basePath     string
+	envelope     func(c *gin.Context, body []byte) []byte
+}
+
+// FactoryOptions represents a configuration option for the GinFactory.
+type FactoryOptions func(g *GinFactory)
+
+// WithPanicHook sets a function invoked by the recovery middleware on each recovered panic.
+// The hook receives the gin context and the recovered value and runs before the 500 response is written,
+// so the matched route is still available via c.FullPath().
+func WithPanicHook(fn func(c *gin.Context, recovered any)) FactoryOptions {
+	return func(g *GinFactory) {
+		g.panicHook = fn
+	}
 }
 
 // NewGinFactory initializes a new instance of GinFactory.
 // It includes the default gin.Recovery middleware to handle panics gracefully.
-func NewGinFactory() *GinFactory {
-	return &GinFactory{middleware: []gin.HandlerFunc{gin.Recovery()}, handlers: make([]func(router *gin.Engine), 0)}
+// Provided options are applied before the recovery middleware is built.
+func NewGinFactory(options ...FactoryOptions) *GinFactory {
+	g := &GinFactory{handlers: make([]func(router *gin.Engine), 0)}
+
+	for _, option := range options {
+		option(g)
+	}
+
+	g.middleware = []gin.HandlerFunc{g.recovery()}
+
+	return g
 }
 
 // AddMiddleware adds middleware to the GinFactory.
@@ -35,6 +68,35 @@ func (g *GinFactory) AddHandlers(handlers ...func(router *gin.Engine)) {
 	g.handlers = append(g.handlers, handlers...)
 }
 
+// Clone returns a copy of the GinFactory with its own middleware and handler slices,
+// so middleware and handlers added to the clone don't affect the original and vice versa.
+func (g *GinFactory) Clone() *GinFactory {
+	return &GinFactory{
+		middleware:   slices.Clone(g.middleware),
+		routeWrapper: slices.Clone(g.routeWrapper),
+		handlers:     slices.Clone(g.handlers),
+		panicHook:    g.panicHook,
+		autoOptions:  g.autoOptions,
+		errorMapper:  g.errorMapper,
+		basePath:     g.basePath,
+		envelope:     g.envelope,
+	}
+}
+
+// recovery returns the recovery middleware. If a panic hook is configured,
+// it is invoked before the request is aborted with http.StatusInternalServerError.
+func (g *GinFactory) recovery() gin.HandlerFunc {
+	if g.panicHook == nil {
+		return gin.Recovery()
+	}
+
+	hook := g.panicHook
+	return gin.CustomRecovery(func(c *gin.Context, recovered any) {
+		hook(c, recovered)
+		c.AbortWithStatus(http.StatusInternalServerError)
+	})
+}
+
 // CreateRouter creates a new gin.Engine instance with the configured middleware and handlers.
 // The Gin router is initialized in release mode for optimal performance.
 func (g *GinFactory) CreateRouter() *gin.Engine {
@@ -43,10 +105,55 @@ func (g *GinFactory) CreateRouter() *gin.Engine {
 	for _, m := range g.middleware {
 		router.Use(m)
 	}
+	for _, m := range g.routeWrapper {
+		router.Use(matchedRouteOnly(m))
+	}
+	if g.envelope != nil {
+		router.Use(responseEnvelope(g.envelope))
+	}
 
+	root := router.RouterGroup
+	if g.basePath != "" {
+		router.RouterGroup = *router.Group(g.basePath)
+	}
 	for _, h := range g.handlers {
 		h(router)
 	}
+	router.RouterGroup = root
+
+	if g.autoOptions {
+		registerAutoOptions(router)
+	}
 
 	return router
 }
+
+// CreateRouterE creates a new gin.Engine instance like CreateRouter, but returns an error
+// instead of panicking when the configuration is invalid, e.g. when the same route is registered twice.
+func (g *GinFactory) CreateRouterE() (router *gin.Engine, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			router = nil
+			err = fmt.Errorf("failed to create router: %v", r)
+		}
+	}()
+
+	return g.CreateRouter(), nil
+}
+
+// MustCreateRouter creates a new gin.Engine instance using CreateRouterE and panics on error.
+// It keeps startup code terse where misconfiguration should stop the application.
+func (g *GinFactory) MustCreateRouter() *gin.Engine {
+	router, err := g.CreateRouterE()
+	if err != nil {
+		panic(err)
+	}
+
+	return router
+}
+
+// CreateHandler creates a router like CreateRouter and returns it as an http.Handler,
+// so callers running behind custom servers or frameworks don't depend on *gin.Engine.
+func (g *GinFactory) CreateHandler() http.Handler {
+	return g.CreateRouter()
+}
@@ -1,6 +1,7 @@
 package gin_factory
 
 import (
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -184,3 +185,171 @@ func TestDefaultRecoveryMiddleware(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, w.Code, "Recovery middleware should handle panics and return 500")
 	assert.Contains(t, w.Body.String(), "", "Response body be empty as default recovery middleware does not include a body in its response when a panic occurs")
 }
+
+func TestWithPanicHook(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var (
+		hookCalled bool
+		recovered  any
+		fullPath   string
+		written    bool
+	)
+	gf := NewGinFactory(WithPanicHook(func(c *gin.Context, rec any) {
+		hookCalled = true
+		recovered = rec
+		fullPath = c.FullPath()
+		written = c.Writer.Written()
+	}))
+
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/panic/:id", func(c *gin.Context) {
+			panic("test panic")
+		})
+	})
+
+	r := gf.CreateRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/panic/42", nil)
+	r.ServeHTTP(w, req)
+
+	// Assertions
+	assert.True(t, hookCalled, "Panic hook should have been called")
+	assert.Equal(t, "test panic", recovered, "Panic hook should receive the recovered value")
+	assert.Equal(t, "/panic/:id", fullPath, "Route path should be available from the context")
+	assert.False(t, written, "Panic hook should run before the response is written")
+	assert.Equal(t, http.StatusInternalServerError, w.Code, "Recovery middleware should return 500")
+}
+
+func TestCreateRouterE(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("valid configuration", func(t *testing.T) {
+		gf := NewGinFactory()
+		gf.AddHandlers(func(r *gin.Engine) {
+			r.GET("/test", func(c *gin.Context) {
+				c.String(http.StatusOK, "test handler")
+			})
+		})
+
+		r, err := gf.CreateRouterE()
+		assert.NoError(t, err, "Valid configuration should not return an error")
+		assert.NotNil(t, r, "Router should be returned")
+	})
+
+	t.Run("duplicate routes", func(t *testing.T) {
+		gf := NewGinFactory()
+		handler := func(r *gin.Engine) {
+			r.GET("/test", func(c *gin.Context) {})
+		}
+		gf.AddHandlers(handler, handler)
+
+		r, err := gf.CreateRouterE()
+		assert.Error(t, err, "Duplicate routes should return an error")
+		assert.Nil(t, r, "Router should not be returned on error")
+	})
+}
+
+func TestMustCreateRouter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("valid configuration", func(t *testing.T) {
+		gf := NewGinFactory()
+		gf.AddHandlers(func(r *gin.Engine) {
+			r.GET("/test", func(c *gin.Context) {
+				c.String(http.StatusOK, "test handler")
+			})
+		})
+
+		var r *gin.Engine
+		assert.NotPanics(t, func() { r = gf.MustCreateRouter() }, "Valid configuration should not panic")
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		r.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code, "Response status should be OK")
+	})
+
+	t.Run("duplicate routes", func(t *testing.T) {
+		gf := NewGinFactory()
+		handler := func(r *gin.Engine) {
+			r.GET("/test", func(c *gin.Context) {})
+		}
+		gf.AddHandlers(handler, handler)
+
+		assert.Panics(t, func() { gf.MustCreateRouter() }, "Duplicate routes should panic")
+	})
+}
+
+func TestCreateHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	gf := NewGinFactory()
+
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/test", func(c *gin.Context) {
+			c.String(http.StatusOK, "handler response")
+		})
+	})
+
+	srv := httptest.NewServer(gf.CreateHandler())
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/test")
+	assert.NoError(t, err, "Request through the handler should succeed")
+	defer func() { _ = resp.Body.Close() }()
+
+	body, _ := io.ReadAll(resp.Body)
+
+	// Assertions
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "Response status code should be 200")
+	assert.Equal(t, "handler response", string(body), "Response body should match the handler's output")
+}
+
+func TestClone(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	base := NewGinFactory()
+
+	base.AddHandlers(func(r *gin.Engine) {
+		r.GET("/base", func(c *gin.Context) {
+			c.String(http.StatusOK, "base")
+		})
+	})
+
+	clone := base.Clone()
+	cloneMiddlewareCalled := false
+	clone.AddMiddleware(func(c *gin.Context) {
+		cloneMiddlewareCalled = true
+		c.Next()
+	})
+	clone.AddHandlers(func(r *gin.Engine) {
+		r.GET("/clone", func(c *gin.Context) {
+			c.String(http.StatusOK, "clone")
+		})
+	})
+
+	// Original route set is unchanged
+	original := base.CreateRouter()
+	assert.Len(t, original.Routes(), 1, "Original should only have its own route")
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/clone", nil)
+	original.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code, "Route added to the clone should not exist in the original")
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest(http.MethodGet, "/base", nil)
+	original.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code, "Original route should be served")
+	assert.False(t, cloneMiddlewareCalled, "Middleware added to the clone should not run in the original")
+
+	// Clone inherits the original's configuration
+	cloned := clone.CreateRouter()
+	assert.Len(t, cloned.Routes(), 2, "Clone should have the base and its own route")
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest(http.MethodGet, "/base", nil)
+	cloned.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code, "Base route should be served by the clone")
+	assert.True(t, cloneMiddlewareCalled, "Middleware added to the clone should run in the clone")
+}
@@ -0,0 +1,53 @@
+package gin_factory
+
+import "github.com/gin-gonic/gin"
+
+// beforeHeadersWriter is a gin.ResponseWriter running hook once right before the response headers are sent,
+// i.e. on the first body write or flush. Middleware should call run after the handler chain returns as well,
+// to cover responses without a body.
+type beforeHeadersWriter struct {
+	gin.ResponseWriter
+	hook func(w gin.ResponseWriter)
+	done bool
+}
+
+// run invokes the hook once, unless the headers were already sent.
+func (w *beforeHeadersWriter) run() {
+	if w.done || w.ResponseWriter.Written() {
+		return
+	}
+	w.done = true
+
+	w.hook(w.ResponseWriter)
+}
+
+func (w *beforeHeadersWriter) WriteHeaderNow() {
+	w.run()
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *beforeHeadersWriter) Write(data []byte) (int, error) {
+	w.run()
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *beforeHeadersWriter) WriteString(s string) (int, error) {
+	w.run()
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *beforeHeadersWriter) Flush() {
+	w.run()
+	w.ResponseWriter.Flush()
+}
+
+// withBeforeHeaders runs hook right before the response headers of the current request are sent.
+func withBeforeHeaders(c *gin.Context, hook func(w gin.ResponseWriter)) {
+	bw := &beforeHeadersWriter{ResponseWriter: c.Writer, hook: hook}
+	c.Writer = bw
+	defer func() { c.Writer = bw.ResponseWriter }()
+
+	c.Next()
+
+	bw.run()
+}
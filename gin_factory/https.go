@@ -0,0 +1,59 @@
+package gin_factory
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireHTTPS modes.
+const (
+	// HTTPSRedirect redirects plaintext requests to the https URL with http.StatusPermanentRedirect.
+	HTTPSRedirect = "redirect"
+	// HTTPSReject rejects plaintext requests with http.StatusForbidden.
+	HTTPSReject = "reject"
+)
+
+// RequireHTTPS returns a middleware enforcing HTTPS behind a TLS-terminating load balancer.
+// A request is considered secure if it was received over TLS or if the forwarded-proto header reports "https".
+// The header defaults to X-Forwarded-Proto and can be overridden with headerName.
+//
+// Plaintext requests are handled according to mode:
+//   - HTTPSRedirect: redirected to the https URL with http.StatusPermanentRedirect (308)
+//   - HTTPSReject:   aborted with http.StatusForbidden and a JSON error
+//
+// Any other mode is treated as HTTPSReject.
+func RequireHTTPS(mode string, headerName ...string) gin.HandlerFunc {
+	header := "X-Forwarded-Proto"
+	if len(headerName) > 0 && headerName[0] != "" {
+		header = headerName[0]
+	}
+
+	return func(c *gin.Context) {
+		if isHTTPS(c.Request, header) {
+			c.Next()
+			return
+		}
+
+		if mode == HTTPSRedirect {
+			target := "https://" + c.Request.Host + requestURI(c.Request)
+			c.Redirect(http.StatusPermanentRedirect, target)
+			c.Abort()
+			return
+		}
+
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "https required"})
+	}
+}
+
+// isHTTPS reports whether r was received over TLS or forwarded from an https client according to header.
+// Only the first value of a comma-separated header is considered, as it was set by the outermost proxy.
+func isHTTPS(r *http.Request, header string) bool {
+	if r.TLS != nil {
+		return true
+	}
+
+	proto, _, _ := strings.Cut(r.Header.Get(header), ",")
+	return strings.EqualFold(strings.TrimSpace(proto), "https")
+}
@@ -0,0 +1,72 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newHTTPSRouter(mode string, headerName ...string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	gf := NewGinFactory()
+	gf.AddMiddleware(RequireHTTPS(mode, headerName...))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/test", func(c *gin.Context) {
+			c.String(http.StatusOK, "test handler")
+		})
+	})
+
+	return gf.CreateRouter()
+}
+
+func TestRequireHTTPS(t *testing.T) {
+	t.Run("https passes", func(t *testing.T) {
+		r := newHTTPSRouter(HTTPSReject)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		req.Header.Set("X-Forwarded-Proto", "HTTPS")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, "Request marked https should pass")
+		assert.Equal(t, "test handler", w.Body.String(), "Response body should match handler output")
+	})
+
+	t.Run("http with redirect", func(t *testing.T) {
+		r := newHTTPSRouter(HTTPSRedirect)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "http://example.com/test?q=1", nil)
+		req.Header.Set("X-Forwarded-Proto", "http")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusPermanentRedirect, w.Code, "Plaintext request should be redirected with 308")
+		assert.Equal(t, "https://example.com/test?q=1", w.Header().Get("Location"), "Redirect should point to the https URL")
+	})
+
+	t.Run("http with reject", func(t *testing.T) {
+		r := newHTTPSRouter(HTTPSReject)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code, "Plaintext request should be rejected with 403")
+		assert.JSONEq(t, `{"error":"https required"}`, w.Body.String(), "Response body should be a JSON error")
+	})
+
+	t.Run("custom header name", func(t *testing.T) {
+		r := newHTTPSRouter(HTTPSReject, "X-Scheme")
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		req.Header.Set("X-Scheme", "https")
+		req.Header.Set("X-Forwarded-Proto", "http")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, "Custom header should be used to detect https")
+	})
+}
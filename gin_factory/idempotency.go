@@ -0,0 +1,157 @@
+package gin_factory
+
+import (
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header carrying the idempotency key.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set to "true" on responses replayed from the store.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+// StoredResponse is a response cached by the Idempotency middleware.
+type StoredResponse struct {
+	Status int
+	Header http.Header
+	Body   []byte
+}
+
+// IdempotencyStore stores responses for the Idempotency middleware.
+// Implementations must be safe for concurrent use.
+type IdempotencyStore interface {
+	// Get returns the response stored under key, if any and not expired.
+	Get(key string) (StoredResponse, bool)
+	// Set stores resp under key for ttl.
+	Set(key string, resp StoredResponse, ttl time.Duration)
+}
+
+// Idempotency returns a middleware deduplicating retried write requests (POST, PUT, PATCH) carrying
+// an Idempotency-Key header. The first request runs the handler chain and its response is stored for ttl;
+// repeated requests with the same key, method and path are served the stored response, marked with
+// the Idempotent-Replayed header, without running the handlers again.
+// Concurrent requests with the same key are serialized, so the handler runs once.
+//
+// Server error responses (5xx) aren't stored, so the request can be retried.
+// Requests without the header or with other methods pass through.
+func Idempotency(store IdempotencyStore, ttl time.Duration) gin.HandlerFunc {
+	locks := &keyedMutex{locks: make(map[string]*refMutex)}
+
+	return func(c *gin.Context) {
+		idempotencyKey := c.GetHeader(IdempotencyKeyHeader)
+		if idempotencyKey == "" || !isWriteMethod(c.Request.Method) {
+			c.Next()
+			return
+		}
+
+		key := c.Request.Method + " " + c.Request.URL.Path + " " + idempotencyKey
+
+		unlock := locks.lock(key)
+		defer unlock()
+
+		if resp, ok := store.Get(key); ok {
+			for name, values := range resp.Header {
+				c.Writer.Header()[name] = slices.Clone(values)
+			}
+			c.Header(IdempotentReplayedHeader, "true")
+			c.Writer.WriteHeader(resp.Status)
+			_, _ = c.Writer.Write(resp.Body)
+			c.Abort()
+			return
+		}
+
+		rec := &recordingWriter{ResponseWriter: c.Writer}
+		c.Writer = rec
+		defer func() { c.Writer = rec.ResponseWriter }()
+
+		c.Next()
+
+		if status := c.Writer.Status(); status < http.StatusInternalServerError {
+			store.Set(key, StoredResponse{
+				Status: status,
+				Header: c.Writer.Header().Clone(),
+				Body:   slices.Clone(rec.body.Bytes()),
+			}, ttl)
+		}
+	}
+}
+
+// NewMemoryIdempotencyStore returns an in-memory IdempotencyStore. Expired entries are removed lazily on access.
+// It is suitable for single-instance deployments and tests; use a shared store when running several instances.
+func NewMemoryIdempotencyStore() IdempotencyStore {
+	return &memoryIdempotencyStore{entries: make(map[string]memoryIdempotencyEntry)}
+}
+
+// memoryIdempotencyStore is an in-memory IdempotencyStore.
+type memoryIdempotencyStore struct {
+	mu      sync.Mutex
+	entries map[string]memoryIdempotencyEntry
+}
+
+type memoryIdempotencyEntry struct {
+	resp    StoredResponse
+	expires time.Time
+}
+
+func (s *memoryIdempotencyStore) Get(key string) (StoredResponse, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[key]
+	if !ok {
+		return StoredResponse{}, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(s.entries, key)
+		return StoredResponse{}, false
+	}
+	return entry.resp, true
+}
+
+func (s *memoryIdempotencyStore) Set(key string, resp StoredResponse, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[key] = memoryIdempotencyEntry{resp: resp, expires: time.Now().Add(ttl)}
+}
+
+// keyedMutex provides a mutex per key, removing unused mutexes.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*refMutex
+}
+
+type refMutex struct {
+	sync.Mutex
+	refs int
+}
+
+// lock locks the mutex for key and returns the function unlocking it.
+func (k *keyedMutex) lock(key string) func() {
+	k.mu.Lock()
+	m, ok := k.locks[key]
+	if !ok {
+		m = &refMutex{}
+		k.locks[key] = m
+	}
+	m.refs++
+	k.mu.Unlock()
+
+	m.Lock()
+
+	return func() {
+		m.Unlock()
+
+		k.mu.Lock()
+		defer k.mu.Unlock()
+		if m.refs--; m.refs == 0 {
+			delete(k.locks, key)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdempotency(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var calls atomic.Int32
+	gf := NewGinFactory()
+	gf.AddMiddleware(Idempotency(NewMemoryIdempotencyStore(), time.Minute))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.POST("/payments", func(c *gin.Context) {
+			n := calls.Add(1)
+			time.Sleep(10 * time.Millisecond)
+			c.Header("X-Payment", strconv.Itoa(int(n)))
+			c.JSON(http.StatusCreated, gin.H{"payment": n})
+		})
+		r.POST("/failing", func(c *gin.Context) {
+			calls.Add(1)
+			c.Status(http.StatusInternalServerError)
+		})
+		r.GET("/payments", func(c *gin.Context) {
+			calls.Add(1)
+			c.Status(http.StatusOK)
+		})
+	})
+	r := gf.CreateRouter()
+
+	send := func(method, path, key string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, path, strings.NewReader(`{"amount":10}`))
+		if key != "" {
+			req.Header.Set(IdempotencyKeyHeader, key)
+		}
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("repeated request is replayed", func(t *testing.T) {
+		calls.Store(0)
+
+		first := send(http.MethodPost, "/payments", "key-1")
+		second := send(http.MethodPost, "/payments", "key-1")
+
+		assert.Equal(t, int32(1), calls.Load(), "Handler should run once")
+		assert.Equal(t, http.StatusCreated, second.Code, "Replayed status should match")
+		assert.Equal(t, first.Body.String(), second.Body.String(), "Replayed body should match")
+		assert.Equal(t, "1", second.Header().Get("X-Payment"), "Replayed headers should match")
+		assert.Empty(t, first.Header().Get(IdempotentReplayedHeader), "First response should not be marked as replayed")
+		assert.Equal(t, "true", second.Header().Get(IdempotentReplayedHeader), "Replayed response should be marked")
+	})
+
+	t.Run("different keys run separately", func(t *testing.T) {
+		calls.Store(0)
+
+		send(http.MethodPost, "/payments", "key-2")
+		send(http.MethodPost, "/payments", "key-3")
+		send(http.MethodPost, "/payments", "")
+
+		assert.Equal(t, int32(3), calls.Load(), "Handler should run for each key and keyless request")
+	})
+
+	t.Run("concurrent requests are serialized", func(t *testing.T) {
+		calls.Store(0)
+
+		var wg sync.WaitGroup
+		bodies := make([]string, 5)
+		for i := range bodies {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				bodies[i] = send(http.MethodPost, "/payments", "key-4").Body.String()
+			}(i)
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(1), calls.Load(), "Handler should run once")
+		for _, body := range bodies {
+			assert.Equal(t, bodies[0], body, "All requests should get the same response")
+		}
+	})
+
+	t.Run("server errors are not stored", func(t *testing.T) {
+		calls.Store(0)
+
+		send(http.MethodPost, "/failing", "key-5")
+		send(http.MethodPost, "/failing", "key-5")
+
+		assert.Equal(t, int32(2), calls.Load(), "Failed request should be retried")
+	})
+
+	t.Run("read methods pass through", func(t *testing.T) {
+		calls.Store(0)
+
+		send(http.MethodGet, "/payments", "key-6")
+		send(http.MethodGet, "/payments", "key-6")
+
+		assert.Equal(t, int32(2), calls.Load(), "GET requests should not be deduplicated")
+	})
+}
+
+func TestMemoryIdempotencyStore(t *testing.T) {
+	store := NewMemoryIdempotencyStore()
+	store.Set("live", StoredResponse{Status: http.StatusOK}, time.Minute)
+	store.Set("expired", StoredResponse{Status: http.StatusOK}, -time.Second)
+
+	resp, ok := store.Get("live")
+	assert.True(t, ok, "Live entry should be found")
+	assert.Equal(t, http.StatusOK, resp.Status, "Stored status should be returned")
+
+	_, ok = store.Get("expired")
+	assert.False(t, ok, "Expired entry should not be found")
+
+	_, ok = store.Get("missing")
+	assert.False(t, ok, "Missing entry should not be found")
+}
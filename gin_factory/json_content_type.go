@@ -0,0 +1,150 @@
+package gin_factory
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// jsonContentType is the content type ForceJSONContentType sets on every response.
+const jsonContentType = "application/json; charset=utf-8"
+
+// ForceJSONContentType returns a middleware ensuring every response is JSON, including error responses
+// written by other middleware, gin's 404 and 405 handlers and the recovery middleware.
+// The Content-Type of each response is set to "application/json; charset=utf-8". Error responses
+// (4xx and 5xx) with a non-JSON body are wrapped in a {"error": "<body>"} envelope, and error responses
+// without a body get {"error": "<status text>"}. Successful responses are passed through as is.
+//
+// The middleware keeps its response writer installed after the handler chain returns, so it should be
+// added right after the recovery middleware to cover panics too.
+func ForceJSONContentType() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		w := &jsonOnlyWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+		defer func() { w.finished = true }()
+
+		c.Next()
+
+		w.finish()
+	}
+}
+
+// jsonOnlyWriter is a gin.ResponseWriter forcing the JSON content type and wrapping non-JSON error bodies.
+// Until the handler chain returns, non-JSON error bodies are buffered so they are wrapped in one envelope;
+// afterwards (e.g. for gin's 404 handler or after a panic), each write is wrapped on its own.
+type jsonOnlyWriter struct {
+	gin.ResponseWriter
+	body     bytes.Buffer
+	wrapping bool
+	finished bool
+}
+
+// wrap reports whether the current response is an error response whose body needs an envelope.
+func (w *jsonOnlyWriter) wrap() bool {
+	if w.wrapping {
+		return true
+	}
+	if w.ResponseWriter.Written() || w.Status() < http.StatusBadRequest {
+		return false
+	}
+
+	w.wrapping = !isJSONContentType(w.Header().Get("Content-Type"))
+	return w.wrapping
+}
+
+// writeEnvelope writes msg as a JSON error envelope, falling back to the status text if msg is empty.
+func (w *jsonOnlyWriter) writeEnvelope(msg string) {
+	if msg = strings.TrimSpace(msg); msg == "" {
+		msg = http.StatusText(w.Status())
+	}
+
+	body, _ := json.Marshal(gin.H{"error": msg})
+	w.Header().Set("Content-Type", jsonContentType)
+	_, _ = w.ResponseWriter.Write(body)
+}
+
+// finish writes the buffered error body in an envelope, or an envelope with the status text
+// for an error response without a body. It sets the content type of responses not written yet.
+func (w *jsonOnlyWriter) finish() {
+	w.finished = true
+
+	if w.wrapping && w.body.Len() > 0 {
+		w.writeEnvelope(w.body.String())
+		w.body.Reset()
+		return
+	}
+	if !w.ResponseWriter.Written() && w.Status() >= http.StatusBadRequest {
+		w.writeEnvelope("")
+		return
+	}
+	w.setContentType()
+}
+
+func (w *jsonOnlyWriter) WriteHeaderNow() {
+	if w.ResponseWriter.Written() {
+		return
+	}
+	if w.Status() >= http.StatusBadRequest {
+		if w.finished {
+			w.writeEnvelope("")
+		}
+		return
+	}
+
+	w.setContentType()
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *jsonOnlyWriter) Write(data []byte) (int, error) {
+	if w.wrap() {
+		if w.finished {
+			w.writeEnvelope(string(data))
+		} else {
+			w.body.Write(data)
+		}
+		return len(data), nil
+	}
+
+	w.setContentType()
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *jsonOnlyWriter) WriteString(s string) (int, error) {
+	if w.wrap() {
+		if w.finished {
+			w.writeEnvelope(s)
+		} else {
+			w.body.WriteString(s)
+		}
+		return len(s), nil
+	}
+
+	w.setContentType()
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *jsonOnlyWriter) Flush() {
+	if w.wrapping && !w.finished {
+		return
+	}
+
+	w.setContentType()
+	w.ResponseWriter.Flush()
+}
+
+// setContentType sets the JSON content type, unless the headers were already sent.
+func (w *jsonOnlyWriter) setContentType() {
+	if !w.ResponseWriter.Written() {
+		w.Header().Set("Content-Type", jsonContentType)
+	}
+}
+
+// isJSONContentType reports whether contentType is application/json or a +json media type.
+func isJSONContentType(contentType string) bool {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
@@ -0,0 +1,78 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForceJSONContentType(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	gf := NewGinFactory()
+	gf.AddMiddleware(ForceJSONContentType())
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/text", func(c *gin.Context) {
+			c.String(http.StatusOK, "plain")
+		})
+		r.GET("/json", func(c *gin.Context) {
+			c.JSON(http.StatusOK, gin.H{"ok": true})
+		})
+		r.GET("/text-error", func(c *gin.Context) {
+			c.String(http.StatusBadRequest, "bad ")
+			c.String(http.StatusBadRequest, "input\n")
+		})
+		r.GET("/json-error", func(c *gin.Context) {
+			c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": "conflict"})
+		})
+		r.GET("/abort", func(c *gin.Context) {
+			c.AbortWithStatus(http.StatusForbidden)
+		})
+		r.GET("/panic", func(c *gin.Context) {
+			panic("boom")
+		})
+		r.GET("/empty", func(c *gin.Context) {
+			c.Status(http.StatusNoContent)
+		})
+	})
+	r := gf.CreateRouter()
+
+	tests := []struct {
+		name         string
+		path         string
+		expectedCode int
+		expectedBody string
+	}{
+		{"plain text success", "/text", http.StatusOK, "plain"},
+		{"json success", "/json", http.StatusOK, `{"ok":true}`},
+		{"plain text error", "/text-error", http.StatusBadRequest, `{"error":"bad input"}`},
+		{"json error", "/json-error", http.StatusConflict, `{"error":"conflict"}`},
+		{"abort without body", "/abort", http.StatusForbidden, `{"error":"Forbidden"}`},
+		{"panic", "/panic", http.StatusInternalServerError, `{"error":"Internal Server Error"}`},
+		{"unmatched route", "/missing", http.StatusNotFound, `{"error":"Not Found"}`},
+		{"no content", "/empty", http.StatusNoContent, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Response status should match")
+			assert.Equal(t, tt.expectedBody, w.Body.String(), "Response body should match")
+			assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"), "Content type should be JSON")
+		})
+	}
+}
+
+func TestIsJSONContentType(t *testing.T) {
+	assert.True(t, isJSONContentType("application/json"))
+	assert.True(t, isJSONContentType("Application/JSON; charset=utf-8"))
+	assert.True(t, isJSONContentType("application/problem+json"))
+	assert.False(t, isJSONContentType("text/plain; charset=utf-8"))
+	assert.False(t, isJSONContentType(""))
+}
@@ -0,0 +1,102 @@
+package gin_factory
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// maxSchemaBodySize is the largest request body ValidateJSONSchema reads.
+const maxSchemaBodySize = 1 << 20
+
+// ValidateJSONSchema returns a middleware validating the JSON request body against schema.
+// The schema is compiled once when the middleware is constructed; ValidateJSONSchema panics if it is invalid,
+// so misconfiguration surfaces at startup.
+//
+// Requests without a body, such as most GET and DELETE requests, are passed through unvalidated.
+// Requests with an invalid body are aborted with http.StatusBadRequest and a JSON body listing the
+// validation errors with their locations in the request body. Bodies larger than 1 MiB are aborted
+// with http.StatusRequestEntityTooLarge without being read in full. On success, the body is restored
+// so handlers can bind it as usual.
+func ValidateJSONSchema(schema []byte) gin.HandlerFunc {
+	compiled, err := compileJSONSchema(schema)
+	if err != nil {
+		panic(fmt.Errorf("invalid JSON schema: %w", err))
+	}
+
+	return func(c *gin.Context) {
+		if c.Request.Body == nil || c.Request.Body == http.NoBody || c.Request.ContentLength == 0 {
+			c.Next()
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxSchemaBodySize))
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{"error": "request body too large"})
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		if len(body) == 0 {
+			c.Next()
+			return
+		}
+
+		instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(body))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid JSON body"})
+			return
+		}
+
+		if err = compiled.Validate(instance); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error":   "request body doesn't match the schema",
+				"details": schemaErrors(err),
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// compileJSONSchema compiles a JSON schema document.
+func compileJSONSchema(schema []byte) (*jsonschema.Schema, error) {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(schema))
+	if err != nil {
+		return nil, err
+	}
+
+	compiler := jsonschema.NewCompiler()
+	if err = compiler.AddResource("schema.json", doc); err != nil {
+		return nil, err
+	}
+
+	return compiler.Compile("schema.json")
+}
+
+// schemaErrors flattens a validation error into a list of located error messages.
+func schemaErrors(err error) []gin.H {
+	var ve *jsonschema.ValidationError
+	if !errors.As(err, &ve) {
+		return []gin.H{{"location": "", "error": err.Error()}}
+	}
+
+	var details []gin.H
+	for _, unit := range ve.BasicOutput().Errors {
+		if unit.Error == nil {
+			continue
+		}
+		details = append(details, gin.H{"location": unit.InstanceLocation, "error": unit.Error})
+	}
+	return details
+}
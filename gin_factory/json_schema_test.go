@@ -0,0 +1,120 @@
+package gin_factory
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const userSchema = `{
+	"type": "object",
+	"required": ["name", "age"],
+	"properties": {
+		"name": {"type": "string"},
+		"age": {"type": "integer", "minimum": 0}
+	}
+}`
+
+func TestValidateJSONSchema(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	gf := NewGinFactory()
+	gf.AddMiddleware(ValidateJSONSchema([]byte(userSchema)))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.POST("/users", func(c *gin.Context) {
+			body, _ := io.ReadAll(c.Request.Body)
+			c.String(http.StatusOK, string(body))
+		})
+		r.GET("/users", func(c *gin.Context) {
+			c.String(http.StatusOK, "listed")
+		})
+	})
+	r := gf.CreateRouter()
+
+	t.Run("valid body", func(t *testing.T) {
+		body := `{"name":"John","age":42}`
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/users", strings.NewReader(body))
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, "Valid body should pass")
+		assert.Equal(t, body, w.Body.String(), "Body should be restored for the handler")
+	})
+
+	t.Run("missing required field", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"name":"John","age":-1}`))
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, "Invalid body should be rejected")
+
+		var resp struct {
+			Error   string `json:"error"`
+			Details []struct {
+				Location string `json:"location"`
+				Error    string `json:"error"`
+			} `json:"details"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, "request body doesn't match the schema", resp.Error, "Response should report the schema mismatch")
+		require.Len(t, resp.Details, 1, "Response should list the validation errors")
+		assert.Equal(t, "/age", resp.Details[0].Location, "Error should be located")
+		assert.Contains(t, resp.Details[0].Error, "minimum", "Error should describe the violation")
+
+		w = httptest.NewRecorder()
+		req, _ = http.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"name":"John"}`))
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, "Body without a required field should be rejected")
+		assert.Contains(t, w.Body.String(), "age", "Response should name the missing field")
+	})
+
+	t.Run("malformed JSON", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"name":`))
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, "Malformed body should be rejected")
+		assert.JSONEq(t, `{"error":"invalid JSON body"}`, w.Body.String(), "Response body should be a JSON error")
+	})
+
+	t.Run("request without a body", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/users", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, "Request without a body should pass")
+		assert.Equal(t, "listed", w.Body.String())
+
+		w = httptest.NewRecorder()
+		req, _ = http.NewRequest(http.MethodPost, "/users", io.NopCloser(strings.NewReader("")))
+		req.ContentLength = -1
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, "Empty body of unknown length should pass")
+	})
+
+	t.Run("body too large", func(t *testing.T) {
+		body := `{"name":"` + strings.Repeat("a", maxSchemaBodySize) + `","age":42}`
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/users", strings.NewReader(body))
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code, "Oversized body should be rejected")
+		assert.JSONEq(t, `{"error":"request body too large"}`, w.Body.String(), "Response body should be a JSON error")
+	})
+}
+
+func TestValidateJSONSchema_InvalidSchema(t *testing.T) {
+	assert.Panics(t, func() { ValidateJSONSchema([]byte(`{"type":`)) }, "Malformed schema should panic")
+	assert.Panics(t, func() { ValidateJSONSchema([]byte(`{"type":"unknown"}`)) }, "Invalid schema should panic")
+}
@@ -0,0 +1,26 @@
+package gin_factory
+
+import (
+	"net/http"
+	"slices"
+	"sync/atomic"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Maintenance returns a middleware that aborts requests with http.StatusServiceUnavailable and a JSON error body
+// while enabled is set. Requests whose URL path exactly matches one of allowPaths, such as health checks,
+// pass through regardless. The flag is read on every request, so maintenance mode can be toggled at runtime.
+// A nil flag never enables maintenance mode.
+func Maintenance(enabled *atomic.Bool, allowPaths ...string) gin.HandlerFunc {
+	allowed := slices.Clone(allowPaths)
+
+	return func(c *gin.Context) {
+		if enabled == nil || !enabled.Load() || slices.Contains(allowed, c.Request.URL.Path) {
+			c.Next()
+			return
+		}
+
+		c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "service is under maintenance"})
+	}
+}
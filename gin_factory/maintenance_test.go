@@ -0,0 +1,61 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaintenance(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var enabled atomic.Bool
+	gf := NewGinFactory()
+	gf.AddMiddleware(Maintenance(&enabled, "/health"))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/test", func(c *gin.Context) {
+			c.String(http.StatusOK, "test handler")
+		})
+		r.GET("/health", func(c *gin.Context) {
+			c.String(http.StatusOK, "healthy")
+		})
+	})
+	r := gf.CreateRouter()
+
+	t.Run("enabled", func(t *testing.T) {
+		enabled.Store(true)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code, "Response status should be Service Unavailable")
+		assert.JSONEq(t, `{"error":"service is under maintenance"}`, w.Body.String(), "Response body should be a JSON error")
+	})
+
+	t.Run("enabled with allowed path", func(t *testing.T) {
+		enabled.Store(true)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/health", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, "Allowed path should pass")
+		assert.Equal(t, "healthy", w.Body.String(), "Response body should match handler output")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		enabled.Store(false)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, "Request should pass when maintenance mode is disabled")
+		assert.Equal(t, "test handler", w.Body.String(), "Response body should match handler output")
+	})
+}
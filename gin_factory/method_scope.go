@@ -0,0 +1,27 @@
+package gin_factory
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MethodScopedMiddleware adds middleware to the GinFactory that executes only for requests with one of
+// the listed HTTP methods (e.g. a CSRF check for POST, PUT, PATCH and DELETE) and is skipped otherwise,
+// letting the rest of the chain run. Methods are compared case-insensitively.
+// Middleware is applied in the order it is added, as with AddMiddleware.
+func (g *GinFactory) MethodScopedMiddleware(methods []string, mw ...gin.HandlerFunc) {
+	scoped := make([]string, len(methods))
+	for i, method := range methods {
+		scoped[i] = strings.ToUpper(method)
+	}
+
+	for _, m := range mw {
+		g.AddMiddleware(func(c *gin.Context) {
+			if slices.Contains(scoped, c.Request.Method) {
+				m(c)
+			}
+		})
+	}
+}
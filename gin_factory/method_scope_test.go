@@ -0,0 +1,73 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMethodScopedMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var ran []string
+	gf := NewGinFactory()
+	gf.MethodScopedMiddleware([]string{http.MethodPost, "delete"},
+		func(c *gin.Context) {
+			ran = append(ran, "first")
+			c.Next()
+		},
+		func(c *gin.Context) {
+			ran = append(ran, "second")
+			if c.GetHeader("X-CSRF-Token") == "" {
+				c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "missing CSRF token"})
+			}
+		},
+	)
+	gf.AddHandlers(func(r *gin.Engine) {
+		handler := func(c *gin.Context) {
+			ran = append(ran, "handler")
+			c.String(http.StatusOK, "test handler")
+		}
+		r.GET("/test", handler)
+		r.POST("/test", handler)
+		r.DELETE("/test", handler)
+	})
+	r := gf.CreateRouter()
+
+	t.Run("scoped method", func(t *testing.T) {
+		ran = nil
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/test", nil)
+		req.Header.Set("X-CSRF-Token", "token")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, "Response status should be OK")
+		assert.Equal(t, []string{"first", "second", "handler"}, ran, "Middleware should run for POST")
+	})
+
+	t.Run("scoped method aborted", func(t *testing.T) {
+		ran = nil
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/test", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code, "Middleware should be able to abort")
+		assert.Equal(t, []string{"first", "second"}, ran, "Handler should not run after abort")
+	})
+
+	t.Run("other method", func(t *testing.T) {
+		ran = nil
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, "Response status should be OK")
+		assert.Equal(t, []string{"handler"}, ran, "Middleware should be skipped for GET")
+	})
+}
@@ -0,0 +1,54 @@
+package gin_factory
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NormalizeHeaders returns a middleware coalescing the named comma-list request headers, e.g. "Accept-Encoding",
+// into a single value before handlers read them. Elements from all header lines are joined with ", "
+// in order of appearance; empty and repeated elements are dropped. Header names are case-insensitive.
+// Repeated elements of hop lists such as "X-Forwarded-For" and "Via" are kept, since every hop is meaningful.
+func NormalizeHeaders(names ...string) gin.HandlerFunc {
+	canonical := make([]string, len(names))
+	for i, name := range names {
+		canonical[i] = http.CanonicalHeaderKey(name)
+	}
+
+	return func(c *gin.Context) {
+		for _, name := range canonical {
+			if values := c.Request.Header.Values(name); len(values) > 0 {
+				c.Request.Header.Set(name, coalesceHeaderValues(values, !hopListHeaders[name]))
+			}
+		}
+
+		c.Next()
+	}
+}
+
+// hopListHeaders are the order-sensitive list headers recording one element per hop.
+var hopListHeaders = map[string]bool{
+	"X-Forwarded-For":   true,
+	"X-Forwarded-Host":  true,
+	"X-Forwarded-Proto": true,
+	"Forwarded":         true,
+	"Via":               true,
+}
+
+// coalesceHeaderValues joins the comma-separated elements of values, skipping empty ones and,
+// if dedup is set, repeated ones.
+func coalesceHeaderValues(values []string, dedup bool) string {
+	var elems []string
+	for _, v := range values {
+		for _, elem := range strings.Split(v, ",") {
+			elem = strings.TrimSpace(elem)
+			if elem != "" && !(dedup && slices.Contains(elems, elem)) {
+				elems = append(elems, elem)
+			}
+		}
+	}
+	return strings.Join(elems, ", ")
+}
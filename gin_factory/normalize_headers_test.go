@@ -0,0 +1,54 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeHeaders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var seen http.Header
+	gf := NewGinFactory()
+	gf.AddMiddleware(NormalizeHeaders("accept-encoding", "X-Tags", "x-forwarded-for", "Via"))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/test", func(c *gin.Context) {
+			seen = c.Request.Header.Clone()
+			c.Status(http.StatusNoContent)
+		})
+	})
+	r := gf.CreateRouter()
+
+	tests := []struct {
+		name     string
+		header   string
+		values   []string
+		expected []string
+	}{
+		{"two lines", "Accept-Encoding", []string{"gzip", "br"}, []string{"gzip, br"}},
+		{"comma lists", "Accept-Encoding", []string{"gzip, deflate", "br,gzip"}, []string{"gzip, deflate, br"}},
+		{"empty elements", "X-Tags", []string{"a,,b", " ", "a"}, []string{"a, b"}},
+		{"single line kept", "X-Tags", []string{"a, b"}, []string{"a, b"}},
+		{"forwarded hops kept", "X-Forwarded-For", []string{"10.0.0.1, 10.0.0.2", "10.0.0.1"}, []string{"10.0.0.1, 10.0.0.2, 10.0.0.1"}},
+		{"via hops kept", "Via", []string{"1.1 proxy", "1.1 proxy"}, []string{"1.1 proxy, 1.1 proxy"}},
+		{"unlisted header untouched", "X-Other", []string{"a", "b"}, []string{"a", "b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+			for _, v := range tt.values {
+				req.Header.Add(tt.header, v)
+			}
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusNoContent, w.Code, "Response status should be No Content")
+			assert.Equal(t, tt.expected, seen.Values(tt.header), "Handler should see the normalized header")
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package gin_factory
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OpenAPIInfo is the info object of the OpenAPI document served by AddOpenAPI.
+type OpenAPIInfo struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// AddOpenAPI registers a GET handler at path ("/openapi.json" if empty) serving a minimal OpenAPI 3 document
+// generated from the routes of the router: the paths, their methods and path parameters, without schemas.
+// The document is built on the first request, so it lists routes registered after AddOpenAPI too.
+// Gin path parameters (":id", "*path") are rendered as OpenAPI templates ("{id}", "{path}").
+func (g *GinFactory) AddOpenAPI(path string, info OpenAPIInfo) {
+	if path == "" {
+		path = "/openapi.json"
+	}
+
+	g.AddHandlers(func(router *gin.Engine) {
+		var (
+			once sync.Once
+			doc  gin.H
+		)
+
+		router.GET(path, func(c *gin.Context) {
+			once.Do(func() {
+				doc = openAPIDocument(router.Routes(), c.FullPath(), info)
+			})
+			c.JSON(http.StatusOK, doc)
+		})
+	})
+}
+
+// openAPIDocument builds the OpenAPI document describing routes, except the one serving the document itself.
+func openAPIDocument(routes gin.RoutesInfo, self string, info OpenAPIInfo) gin.H {
+	paths := gin.H{}
+	for _, route := range routes {
+		if route.Path == self {
+			continue
+		}
+
+		template, params := openAPIPath(route.Path)
+		operations, ok := paths[template].(gin.H)
+		if !ok {
+			operations = gin.H{}
+			paths[template] = operations
+		}
+
+		operation := gin.H{
+			"responses": gin.H{"default": gin.H{"description": "response"}},
+		}
+		if len(params) > 0 {
+			parameters := make([]gin.H, len(params))
+			for i, name := range params {
+				parameters[i] = gin.H{"name": name, "in": "path", "required": true, "schema": gin.H{"type": "string"}}
+			}
+			operation["parameters"] = parameters
+		}
+		operations[strings.ToLower(route.Method)] = operation
+	}
+
+	return gin.H{
+		"openapi": "3.0.3",
+		"info":    info,
+		"paths":   paths,
+	}
+}
+
+// openAPIPath converts a gin route path to an OpenAPI path template and returns the names of its parameters.
+func openAPIPath(path string) (string, []string) {
+	segments := strings.Split(path, "/")
+	var params []string
+	for i, segment := range segments {
+		if segment != "" && (segment[0] == ':' || segment[0] == '*') {
+			params = append(params, segment[1:])
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/"), params
+}
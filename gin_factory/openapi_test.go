@@ -0,0 +1,58 @@
+package gin_factory
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddOpenAPI(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	noop := func(c *gin.Context) {}
+	gf := NewGinFactory()
+	gf.AddOpenAPI("", OpenAPIInfo{Title: "orders", Version: "1.2.0"})
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/orders", noop)
+		r.POST("/orders", noop)
+		r.GET("/orders/:id", noop)
+		r.GET("/files/*path", noop)
+	})
+	r := gf.CreateRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/openapi.json", nil)
+	r.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code, "Response status should be OK")
+
+	var doc struct {
+		OpenAPI string                    `json:"openapi"`
+		Info    OpenAPIInfo               `json:"info"`
+		Paths   map[string]map[string]any `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &doc), "Response should be JSON")
+
+	assert.Equal(t, "3.0.3", doc.OpenAPI, "Document should declare the OpenAPI version")
+	assert.Equal(t, OpenAPIInfo{Title: "orders", Version: "1.2.0"}, doc.Info, "Document should carry the info")
+	assert.Len(t, doc.Paths, 3, "Document should list the registered paths except its own")
+	assert.Contains(t, doc.Paths["/orders"], "get", "Document should list GET /orders")
+	assert.Contains(t, doc.Paths["/orders"], "post", "Document should list POST /orders")
+	assert.Contains(t, doc.Paths["/files/{path}"], "get", "Document should render catch-all parameters")
+
+	assert.JSONEq(t, `{
+		"parameters": [{"name": "id", "in": "path", "required": true, "schema": {"type": "string"}}],
+		"responses": {"default": {"description": "response"}}
+	}`, mustJSON(t, doc.Paths["/orders/{id}"]["get"]), "Operation should declare its path parameters")
+}
+
+func mustJSON(t *testing.T, v any) string {
+	t.Helper()
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	return string(b)
+}
@@ -0,0 +1,45 @@
+package gin_factory
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// WithAutoOptions makes CreateRouter register an OPTIONS handler for every path that doesn't define one.
+// The handler responds with http.StatusNoContent and an Allow header listing the methods registered
+// for the path, followed by OPTIONS, e.g. "GET, POST, OPTIONS".
+func WithAutoOptions() FactoryOptions {
+	return func(g *GinFactory) {
+		g.autoOptions = true
+	}
+}
+
+// registerAutoOptions registers the OPTIONS handlers for the routes registered on router.
+func registerAutoOptions(router *gin.Engine) {
+	methods := make(map[string][]string)
+	var paths []string
+	for _, route := range router.Routes() {
+		if _, ok := methods[route.Path]; !ok {
+			paths = append(paths, route.Path)
+		}
+		methods[route.Path] = append(methods[route.Path], route.Method)
+	}
+
+	for _, path := range paths {
+		if slices.Contains(methods[path], http.MethodOptions) {
+			continue
+		}
+
+		allowed := methods[path]
+		slices.Sort(allowed)
+		allow := strings.Join(append(allowed, http.MethodOptions), ", ")
+
+		router.OPTIONS(path, func(c *gin.Context) {
+			c.Header("Allow", allow)
+			c.Status(http.StatusNoContent)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithAutoOptions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	gf := NewGinFactory(WithAutoOptions())
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/read", func(c *gin.Context) {
+			c.String(http.StatusOK, "read")
+		})
+		r.POST("/users/:id", func(c *gin.Context) {})
+		r.GET("/users/:id", func(c *gin.Context) {})
+		r.DELETE("/users/:id", func(c *gin.Context) {})
+		r.OPTIONS("/custom", func(c *gin.Context) {
+			c.String(http.StatusOK, "custom")
+		})
+		r.GET("/custom", func(c *gin.Context) {})
+	})
+	r := gf.CreateRouter()
+
+	options := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodOptions, path, nil)
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("GET-only route", func(t *testing.T) {
+		w := options("/read")
+
+		assert.Equal(t, http.StatusNoContent, w.Code, "Response status should be No Content")
+		assert.Equal(t, "GET, OPTIONS", w.Header().Get("Allow"), "Allow header should list the registered methods")
+	})
+
+	t.Run("route with several methods", func(t *testing.T) {
+		w := options("/users/42")
+
+		assert.Equal(t, http.StatusNoContent, w.Code, "Response status should be No Content")
+		assert.Equal(t, "DELETE, GET, POST, OPTIONS", w.Header().Get("Allow"), "Allow header should list the registered methods")
+	})
+
+	t.Run("explicit OPTIONS handler is kept", func(t *testing.T) {
+		w := options("/custom")
+
+		assert.Equal(t, http.StatusOK, w.Code, "Explicit handler should be used")
+		assert.Equal(t, "custom", w.Body.String(), "Response body should match handler output")
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		gf := NewGinFactory()
+		gf.AddHandlers(func(r *gin.Engine) {
+			r.GET("/read", func(c *gin.Context) {})
+		})
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodOptions, "/read", nil)
+		gf.CreateRouter().ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code, "OPTIONS should not be handled without the option")
+	})
+}
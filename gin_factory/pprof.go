@@ -0,0 +1,48 @@
+package gin_factory
+
+import (
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// pprofProfiles lists the named runtime profiles served by AddPprof in addition to the index.
+var pprofProfiles = []string{"allocs", "block", "goroutine", "heap", "mutex", "threadcreate"}
+
+// AddPprof registers the net/http/pprof handlers under prefix (e.g. "/debug/pprof").
+// The guard middleware runs before every pprof handler and should abort unauthorized requests.
+// If guard is nil, only requests from loopback addresses are allowed; others get http.StatusForbidden.
+func (g *GinFactory) AddPprof(prefix string, guard gin.HandlerFunc) {
+	if guard == nil {
+		guard = loopbackOnly
+	}
+	prefix = "/" + strings.Trim(prefix, "/")
+
+	g.AddHandlers(func(router *gin.Engine) {
+		group := router.Group(prefix, guard)
+
+		group.GET("/", gin.WrapF(pprof.Index))
+		group.GET("/cmdline", gin.WrapF(pprof.Cmdline))
+		group.GET("/profile", gin.WrapF(pprof.Profile))
+		group.GET("/symbol", gin.WrapF(pprof.Symbol))
+		group.POST("/symbol", gin.WrapF(pprof.Symbol))
+		group.GET("/trace", gin.WrapF(pprof.Trace))
+		for _, name := range pprofProfiles {
+			group.GET("/"+name, gin.WrapH(pprof.Handler(name)))
+		}
+	})
+}
+
+// loopbackOnly is a middleware aborting requests from non-loopback addresses with http.StatusForbidden.
+func loopbackOnly(c *gin.Context) {
+	ip := net.ParseIP(c.RemoteIP())
+	if ip == nil || !ip.IsLoopback() {
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "forbidden"})
+		return
+	}
+
+	c.Next()
+}
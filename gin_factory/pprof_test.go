@@ -0,0 +1,66 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddPprof(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("guard passes", func(t *testing.T) {
+		gf := NewGinFactory()
+		gf.AddPprof("/debug/pprof", func(c *gin.Context) {
+			if c.GetHeader("X-Token") != "secret" {
+				c.AbortWithStatus(http.StatusUnauthorized)
+				return
+			}
+			c.Next()
+		})
+		r := gf.CreateRouter()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/debug/pprof/heap?debug=1", nil)
+		req.Header.Set("X-Token", "secret")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, "Profile should be reachable when the guard passes")
+		assert.Contains(t, w.Body.String(), "heap profile", "Response should contain the heap profile")
+	})
+
+	t.Run("guard fails", func(t *testing.T) {
+		gf := NewGinFactory()
+		gf.AddPprof("/debug/pprof", func(c *gin.Context) {
+			c.AbortWithStatus(http.StatusUnauthorized)
+		})
+		r := gf.CreateRouter()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/debug/pprof/", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code, "Profile should be blocked when the guard fails")
+	})
+
+	t.Run("default guard", func(t *testing.T) {
+		gf := NewGinFactory()
+		gf.AddPprof("/internal/pprof/", nil)
+		r := gf.CreateRouter()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/internal/pprof/cmdline", nil)
+		req.RemoteAddr = "127.0.0.1:12345"
+		r.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code, "Loopback requests should pass the default guard")
+
+		w = httptest.NewRecorder()
+		req, _ = http.NewRequest(http.MethodGet, "/internal/pprof/cmdline", nil)
+		req.RemoteAddr = "192.0.2.1:12345"
+		r.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusForbidden, w.Code, "Remote requests should be blocked by the default guard")
+	})
+}
@@ -0,0 +1,53 @@
+package gin_factory
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+)
+
+// propagatedHeadersKey is the gin context key under which PropagateHeaders stores the propagated headers.
+const propagatedHeadersKey = "gin_factory.propagated_headers"
+
+// PropagateHeaders returns a middleware that reads the named request headers (e.g. X-Correlation-ID),
+// stores them in the gin context and sets them on the response.
+// Headers missing from the request are neither stored nor set on the response.
+// Use PropagatedHeaders to copy them onto outbound requests.
+func PropagateHeaders(names ...string) gin.HandlerFunc {
+	canonical := make([]string, 0, len(names))
+	for _, name := range names {
+		canonical = append(canonical, http.CanonicalHeaderKey(name))
+	}
+
+	return func(c *gin.Context) {
+		headers := make(http.Header, len(canonical))
+		for _, name := range canonical {
+			if values := c.Request.Header.Values(name); len(values) > 0 {
+				// Each destination gets its own slice, so appending to one doesn't change the others.
+				headers[name] = slices.Clone(values)
+				c.Writer.Header()[name] = slices.Clone(values)
+			}
+		}
+		c.Set(propagatedHeadersKey, headers)
+
+		c.Next()
+	}
+}
+
+// PropagatedHeaders returns a copy of the headers stored by PropagateHeaders for use on outbound requests.
+// It returns an empty http.Header if the middleware isn't installed.
+//
+// Example usage:
+//
+//	for name, values := range gin_factory.PropagatedHeaders(c) {
+//	    outReq.Header[name] = values
+//	}
+func PropagatedHeaders(c *gin.Context) http.Header {
+	if v, ok := c.Get(propagatedHeadersKey); ok {
+		if headers, ok := v.(http.Header); ok {
+			return headers.Clone()
+		}
+	}
+	return http.Header{}
+}
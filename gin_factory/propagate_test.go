@@ -0,0 +1,67 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPropagateHeaders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var propagated http.Header
+	gf := NewGinFactory()
+	gf.AddMiddleware(PropagateHeaders("x-correlation-id", "X-Baggage"))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/test", func(c *gin.Context) {
+			propagated = PropagatedHeaders(c)
+			c.String(http.StatusOK, "test handler")
+		})
+	})
+	r := gf.CreateRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+	req.Header.Set("X-Correlation-ID", "abc-123")
+	r.ServeHTTP(w, req)
+
+	// Assertions
+	assert.Equal(t, http.StatusOK, w.Code, "Response status should be OK")
+	assert.Equal(t, "abc-123", w.Header().Get("X-Correlation-ID"), "Present header should be echoed")
+	assert.Empty(t, w.Header().Values("X-Baggage"), "Missing header should be absent from the response")
+	assert.Equal(t, "abc-123", propagated.Get("X-Correlation-ID"), "Present header should be available for outbound requests")
+	assert.Empty(t, propagated.Values("X-Baggage"), "Missing header should not be propagated")
+}
+
+func TestPropagatedHeadersWithoutMiddleware(t *testing.T) {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+	assert.Empty(t, PropagatedHeaders(c), "Headers should be empty without the middleware")
+}
+
+func TestPropagateHeadersCopiesValues(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	gf := NewGinFactory()
+	gf.AddMiddleware(PropagateHeaders("X-Baggage"))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/test", func(c *gin.Context) {
+			c.Writer.Header().Add("X-Baggage", "response")
+			c.Request.Header.Add("X-Baggage", "request")
+			c.Status(http.StatusNoContent)
+		})
+	})
+	r := gf.CreateRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+	// Spare capacity lets appends share the backing array if the slice isn't copied.
+	req.Header["X-Baggage"] = append(make([]string, 0, 4), "a")
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, []string{"a", "response"}, w.Header().Values("X-Baggage"), "Response header should not change with the request header")
+	assert.Equal(t, []string{"a", "request"}, req.Header.Values("X-Baggage"), "Request header should not change with the response header")
+}
@@ -0,0 +1,37 @@
+package gin_factory
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// LimitQueryParams returns a middleware aborting requests with more than maxParams query parameters
+// with http.StatusBadRequest and a JSON error, before handlers parse the query. Repeated keys count
+// once per occurrence. Parameters are counted on the raw query without parsing it, so oversized queries
+// are rejected in linear time. A maxParams of 0 or below disables the check.
+func LimitQueryParams(maxParams int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if maxParams > 0 && countQueryParams(c.Request.URL.RawQuery) > maxParams {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "too many query parameters"})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// countQueryParams returns the number of non-empty '&'-separated parameters in a raw query,
+// the ones url.ParseQuery would consider.
+func countQueryParams(rawQuery string) int {
+	n := 0
+	for rawQuery != "" {
+		var param string
+		param, rawQuery, _ = strings.Cut(rawQuery, "&")
+		if param != "" {
+			n++
+		}
+	}
+	return n
+}
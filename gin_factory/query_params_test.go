@@ -0,0 +1,54 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimitQueryParams(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(maxParams int) *gin.Engine {
+		gf := NewGinFactory()
+		gf.AddMiddleware(LimitQueryParams(maxParams))
+		gf.AddHandlers(func(r *gin.Engine) {
+			r.GET("/search", func(c *gin.Context) {
+				c.String(http.StatusOK, c.Query("q"))
+			})
+		})
+		return gf.CreateRouter()
+	}
+
+	tests := []struct {
+		name         string
+		maxParams    int
+		query        string
+		expectedCode int
+	}{
+		{"no query", 3, "", http.StatusOK},
+		{"under the limit", 3, "q=go&page=2", http.StatusOK},
+		{"at the limit", 3, "q=go&page=2&sort=asc", http.StatusOK},
+		{"empty segments ignored", 3, "&q=go&&page=2&sort=asc&", http.StatusOK},
+		{"over the limit", 3, "q=go&page=2&sort=asc&limit=10", http.StatusBadRequest},
+		{"repeated keys", 3, "id=1&id=2&id=3&id=4", http.StatusBadRequest},
+		{"disabled", 0, strings.Repeat("a=1&", 1000), http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/search?"+tt.query, nil)
+			newRouter(tt.maxParams).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Response status should match")
+			if tt.expectedCode == http.StatusBadRequest {
+				assert.JSONEq(t, `{"error":"too many query parameters"}`, w.Body.String(), "Response body should describe the error")
+			}
+		})
+	}
+}
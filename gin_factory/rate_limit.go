@@ -0,0 +1,91 @@
+package gin_factory
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxRateIdleTTL caps the period after which RateLimitByKey forgets an idle bucket.
+const maxRateIdleTTL = 24 * time.Hour
+
+// rateBucket is the token bucket RateLimitByKey keeps for a key.
+type rateBucket struct {
+	tokens   float64
+	lastSeen time.Time
+}
+
+// RateLimitByKey returns a middleware limiting requests per key, e.g. the authenticated principal set by
+// an earlier auth middleware, to rps requests per second with bursts of up to burst requests.
+// Each key gets its own token bucket; requests with an empty key, or all requests if keyFn is nil,
+// are bucketed by c.ClientIP(). Requests exceeding the budget are aborted with http.StatusTooManyRequests
+// and a JSON error. Buckets idle long enough to refill completely, or for 24 hours, are forgotten,
+// so memory stays bounded by the active keys. A burst below 1 is treated as 1; an rps of 0 or below
+// disables the limit.
+func RateLimitByKey(keyFn func(c *gin.Context) string, rps float64, burst int) gin.HandlerFunc {
+	if rps <= 0 {
+		return func(c *gin.Context) {
+			c.Next()
+		}
+	}
+	if keyFn == nil {
+		keyFn = func(c *gin.Context) string { return "" }
+	}
+	capacity := float64(max(burst, 1))
+	// The refill period is compared in seconds, as a tiny rps overflows time.Duration.
+	idleTTL := maxRateIdleTTL
+	if refill := capacity / rps; refill < idleTTL.Seconds() {
+		idleTTL = time.Duration(refill * float64(time.Second))
+	}
+
+	var (
+		mu        sync.Mutex
+		buckets   = make(map[string]*rateBucket)
+		lastSweep = time.Now()
+	)
+
+	allow := func(key string) bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		now := time.Now()
+		if now.Sub(lastSweep) >= idleTTL {
+			for k, b := range buckets {
+				if now.Sub(b.lastSeen) >= idleTTL {
+					delete(buckets, k)
+				}
+			}
+			lastSweep = now
+		}
+
+		b, ok := buckets[key]
+		if !ok {
+			b = &rateBucket{tokens: capacity, lastSeen: now}
+			buckets[key] = b
+		}
+		b.tokens = min(capacity, b.tokens+now.Sub(b.lastSeen).Seconds()*rps)
+		b.lastSeen = now
+
+		if b.tokens < 1 {
+			return false
+		}
+		b.tokens--
+		return true
+	}
+
+	return func(c *gin.Context) {
+		key := keyFn(c)
+		if key == "" {
+			key = c.ClientIP()
+		}
+
+		if !allow(key) {
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "rate limit exceeded"})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,102 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitByKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(rps float64, burst int) *gin.Engine {
+		gf := NewGinFactory()
+		gf.AddMiddleware(RateLimitByKey(func(c *gin.Context) string {
+			return c.GetHeader("X-User")
+		}, rps, burst))
+		gf.AddHandlers(func(r *gin.Engine) {
+			r.GET("/test", func(c *gin.Context) {
+				c.String(http.StatusOK, "test handler")
+			})
+		})
+		return gf.CreateRouter()
+	}
+
+	send := func(r *gin.Engine, user, remoteAddr string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		if user != "" {
+			req.Header.Set("X-User", user)
+		}
+		req.RemoteAddr = remoteAddr
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("independent budgets per key", func(t *testing.T) {
+		r := newRouter(0.001, 2)
+
+		for i := 0; i < 2; i++ {
+			assert.Equal(t, http.StatusOK, send(r, "alice", "10.0.0.1:1234").Code, "alice should be within budget")
+		}
+		w := send(r, "alice", "10.0.0.1:1234")
+		assert.Equal(t, http.StatusTooManyRequests, w.Code, "alice should exceed the budget")
+		assert.JSONEq(t, `{"error":"rate limit exceeded"}`, w.Body.String(), "Response body should describe the error")
+
+		for i := 0; i < 2; i++ {
+			assert.Equal(t, http.StatusOK, send(r, "bob", "10.0.0.1:1234").Code, "bob should have a separate budget behind the same IP")
+		}
+		assert.Equal(t, http.StatusTooManyRequests, send(r, "bob", "10.0.0.1:1234").Code, "bob should exceed the budget")
+	})
+
+	t.Run("empty key falls back to IP", func(t *testing.T) {
+		r := newRouter(0.001, 1)
+
+		assert.Equal(t, http.StatusOK, send(r, "", "10.0.0.1:1234").Code, "first IP should be within budget")
+		assert.Equal(t, http.StatusTooManyRequests, send(r, "", "10.0.0.1:5678").Code, "first IP should exceed the budget")
+		assert.Equal(t, http.StatusOK, send(r, "", "10.0.0.2:1234").Code, "second IP should have its own budget")
+	})
+
+	t.Run("budget refills", func(t *testing.T) {
+		r := newRouter(50, 1)
+
+		assert.Equal(t, http.StatusOK, send(r, "alice", "10.0.0.1:1234").Code, "first request should be within budget")
+		assert.Equal(t, http.StatusTooManyRequests, send(r, "alice", "10.0.0.1:1234").Code, "second request should exceed the budget")
+		time.Sleep(30 * time.Millisecond)
+		assert.Equal(t, http.StatusOK, send(r, "alice", "10.0.0.1:1234").Code, "budget should refill over time")
+	})
+
+	t.Run("tiny rate", func(t *testing.T) {
+		r := newRouter(1e-12, 1)
+
+		assert.Equal(t, http.StatusOK, send(r, "alice", "10.0.0.1:1234").Code, "first request should be within budget")
+		assert.Equal(t, http.StatusTooManyRequests, send(r, "alice", "10.0.0.1:1234").Code, "second request should exceed the budget")
+	})
+
+	t.Run("nil key function falls back to IP", func(t *testing.T) {
+		gf := NewGinFactory()
+		gf.AddMiddleware(RateLimitByKey(nil, 0.001, 1))
+		gf.AddHandlers(func(r *gin.Engine) {
+			r.GET("/test", func(c *gin.Context) {
+				c.String(http.StatusOK, "test handler")
+			})
+		})
+		r := gf.CreateRouter()
+
+		assert.Equal(t, http.StatusOK, send(r, "alice", "10.0.0.1:1234").Code, "first IP should be within budget")
+		assert.Equal(t, http.StatusTooManyRequests, send(r, "bob", "10.0.0.1:5678").Code, "first IP should exceed the budget")
+		assert.Equal(t, http.StatusOK, send(r, "alice", "10.0.0.2:1234").Code, "second IP should have its own budget")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		r := newRouter(0, 1)
+
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, http.StatusOK, send(r, "alice", "10.0.0.1:1234").Code, "requests should pass through")
+		}
+	})
+}
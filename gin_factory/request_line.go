@@ -0,0 +1,47 @@
+package gin_factory
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// LimitRequestLine returns a middleware rejecting requests with an over-long URL or oversized headers.
+// Requests whose URL exceeds maxURLLen bytes are aborted with http.StatusRequestURITooLong,
+// requests whose headers exceed maxHeaderBytes in total are aborted with http.StatusRequestHeaderFieldsTooLarge.
+// Header size is computed as in the HTTP/1.1 wire format ("Key: value\r\n" per value).
+// A limit of 0 or below disables the corresponding check.
+func LimitRequestLine(maxURLLen int, maxHeaderBytes int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if maxURLLen > 0 && len(requestURI(c.Request)) > maxURLLen {
+			c.AbortWithStatusJSON(http.StatusRequestURITooLong, gin.H{"error": "request URI too long"})
+			return
+		}
+
+		if maxHeaderBytes > 0 && headerSize(c.Request.Header) > maxHeaderBytes {
+			c.AbortWithStatusJSON(http.StatusRequestHeaderFieldsTooLarge, gin.H{"error": "request header fields too large"})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// requestURI returns the unmodified request-target of the request, falling back to the parsed URL.
+func requestURI(r *http.Request) string {
+	if r.RequestURI != "" {
+		return r.RequestURI
+	}
+	return r.URL.RequestURI()
+}
+
+// headerSize returns the size of h in the HTTP/1.1 wire format.
+func headerSize(h http.Header) int {
+	size := 0
+	for key, values := range h {
+		for _, value := range values {
+			size += len(key) + len(": ") + len(value) + len("\r\n")
+		}
+	}
+	return size
+}
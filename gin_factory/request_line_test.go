@@ -0,0 +1,61 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRequestLineRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	gf := NewGinFactory()
+	gf.AddMiddleware(LimitRequestLine(64, 256))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/test", func(c *gin.Context) {
+			c.String(http.StatusOK, "test handler")
+		})
+	})
+
+	return gf.CreateRouter()
+}
+
+func TestLimitRequestLine(t *testing.T) {
+	t.Run("within limits", func(t *testing.T) {
+		r := newRequestLineRouter()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/test?q=1", nil)
+		req.Header.Set("X-Custom-Header", "value")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, "Request within limits should pass")
+	})
+
+	t.Run("over-long URL", func(t *testing.T) {
+		r := newRequestLineRouter()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/test?q="+strings.Repeat("a", 64), nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusRequestURITooLong, w.Code, "Over-long URL should return 414")
+		assert.JSONEq(t, `{"error":"request URI too long"}`, w.Body.String(), "Response body should be a JSON error")
+	})
+
+	t.Run("oversized headers", func(t *testing.T) {
+		r := newRequestLineRouter()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		req.Header.Set("X-Custom-Header", strings.Repeat("a", 128))
+		req.Header.Add("X-Custom-Header", strings.Repeat("b", 128))
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusRequestHeaderFieldsTooLarge, w.Code, "Oversized headers should return 431")
+		assert.JSONEq(t, `{"error":"request header fields too large"}`, w.Body.String(), "Response body should be a JSON error")
+	})
+}
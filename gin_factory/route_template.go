@@ -0,0 +1,23 @@
+package gin_factory
+
+import "github.com/gin-gonic/gin"
+
+// routeTemplateKey is the gin context key under which RouteTemplate stores the matched route template.
+const routeTemplateKey = "gin_factory.route_template"
+
+// RouteTemplate returns a middleware storing the matched route template (e.g. "/users/:id" rather than
+// "/users/42") in the gin context, so downstream metrics and logging middleware can label requests
+// without a cardinality explosion. Use RouteTemplateFromContext to read it.
+func RouteTemplate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(routeTemplateKey, c.FullPath())
+
+		c.Next()
+	}
+}
+
+// RouteTemplateFromContext returns the route template stored by RouteTemplate.
+// It returns an empty string if the middleware isn't installed or the request didn't match a route.
+func RouteTemplateFromContext(c *gin.Context) string {
+	return c.GetString(routeTemplateKey)
+}
@@ -0,0 +1,57 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouteTemplate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var template string
+	gf := NewGinFactory()
+	gf.AddMiddleware(RouteTemplate(), func(c *gin.Context) {
+		c.Next()
+		template = RouteTemplateFromContext(c)
+	})
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/users/:id", func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+	})
+	r := gf.CreateRouter()
+
+	tests := []struct {
+		name             string
+		path             string
+		expectedCode     int
+		expectedTemplate string
+	}{
+		{"matched route", "/users/42", http.StatusOK, "/users/:id"},
+		{"unmatched route", "/missing", http.StatusNotFound, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template = "unset"
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Response status should match")
+			assert.Equal(t, tt.expectedTemplate, template, "Route template should be stored in the context")
+		})
+	}
+}
+
+func TestRouteTemplateFromContextWithoutMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	assert.Empty(t, RouteTemplateFromContext(c), "Route template should be empty without the middleware")
+}
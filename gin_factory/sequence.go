@@ -0,0 +1,93 @@
+package gin_factory
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// defaultSequenceSessionTTL is the inactivity period after which SequenceGuard forgets a session by default.
+const defaultSequenceSessionTTL = 10 * time.Minute
+
+// sequenceGuardConfig holds the configuration of SequenceGuard.
+type sequenceGuardConfig struct {
+	sessionTTL time.Duration
+}
+
+// SequenceGuardOptions represents a configuration option for SequenceGuard.
+type SequenceGuardOptions func(cfg *sequenceGuardConfig)
+
+// WithSessionTTL makes SequenceGuard forget sessions inactive for ttl instead of 10 minutes.
+// A non-positive ttl keeps the default.
+func WithSessionTTL(ttl time.Duration) SequenceGuardOptions {
+	return func(cfg *sequenceGuardConfig) {
+		if ttl > 0 {
+			cfg.sessionTTL = ttl
+		}
+	}
+}
+
+// sequenceSession is the state SequenceGuard keeps for a client session.
+type sequenceSession struct {
+	last     uint64
+	lastSeen time.Time
+}
+
+// SequenceGuard returns a middleware rejecting out-of-order requests of stateful client sessions.
+// extract returns the session and the monotonic sequence number carried by the request (e.g. in headers);
+// requests whose sequence isn't greater than the last one seen for the session are aborted with
+// http.StatusConflict and a JSON error. Requests for which extract returns false pass through unchecked.
+// Sessions inactive for 10 minutes, or the period set with WithSessionTTL, are forgotten, so their next request
+// is accepted with any sequence.
+func SequenceGuard(extract func(c *gin.Context) (session string, seq uint64, ok bool), options ...SequenceGuardOptions) gin.HandlerFunc {
+	cfg := &sequenceGuardConfig{sessionTTL: defaultSequenceSessionTTL}
+	for _, option := range options {
+		option(cfg)
+	}
+
+	var (
+		mu        sync.Mutex
+		sessions  = make(map[string]*sequenceSession)
+		lastSweep = time.Now()
+	)
+
+	accept := func(session string, seq uint64) bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		now := time.Now()
+		if now.Sub(lastSweep) >= cfg.sessionTTL {
+			for id, s := range sessions {
+				if now.Sub(s.lastSeen) >= cfg.sessionTTL {
+					delete(sessions, id)
+				}
+			}
+			lastSweep = now
+		}
+
+		s, ok := sessions[session]
+		if ok && now.Sub(s.lastSeen) < cfg.sessionTTL && seq <= s.last {
+			return false
+		}
+
+		sessions[session] = &sequenceSession{last: seq, lastSeen: now}
+		return true
+	}
+
+	return func(c *gin.Context) {
+		session, seq, ok := extract(c)
+		if !ok {
+			c.Next()
+			return
+		}
+
+		if !accept(session, seq) {
+			c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": "out-of-order request"})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,92 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSequenceGuard(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	gf := NewGinFactory()
+	gf.AddMiddleware(SequenceGuard(func(c *gin.Context) (string, uint64, bool) {
+		session := c.GetHeader("X-Session")
+		seq, err := strconv.ParseUint(c.GetHeader("X-Seq"), 10, 64)
+		return session, seq, session != "" && err == nil
+	}))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.POST("/op", func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+	})
+	r := gf.CreateRouter()
+
+	send := func(session, seq string) int {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/op", nil)
+		if session != "" {
+			req.Header.Set("X-Session", session)
+		}
+		req.Header.Set("X-Seq", seq)
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	tests := []struct {
+		name         string
+		session      string
+		seq          string
+		expectedCode int
+	}{
+		{"first request", "a", "1", http.StatusOK},
+		{"in order", "a", "2", http.StatusOK},
+		{"gap is in order", "a", "5", http.StatusOK},
+		{"duplicate", "a", "5", http.StatusConflict},
+		{"out of order", "a", "3", http.StatusConflict},
+		{"other session", "b", "1", http.StatusOK},
+		{"next after rejection", "a", "6", http.StatusOK},
+		{"no session", "", "0", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedCode, send(tt.session, tt.seq), "Response status should match")
+		})
+	}
+}
+
+func TestSequenceGuardSessionExpiry(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	seq := uint64(5)
+	gf := NewGinFactory()
+	gf.AddMiddleware(SequenceGuard(func(c *gin.Context) (string, uint64, bool) {
+		return "a", seq, true
+	}, WithSessionTTL(20*time.Millisecond)))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.POST("/op", func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+	})
+	r := gf.CreateRouter()
+
+	send := func() int {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/op", nil)
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusOK, send(), "First request should pass")
+	seq = 1
+	assert.Equal(t, http.StatusConflict, send(), "Lower sequence should be rejected")
+
+	time.Sleep(30 * time.Millisecond)
+	assert.Equal(t, http.StatusOK, send(), "Expired session should accept any sequence")
+}
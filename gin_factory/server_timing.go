@@ -0,0 +1,22 @@
+package gin_factory
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ServerTiming returns a middleware reporting the handler duration in a "Server-Timing: app;dur=<ms>" response header.
+// The duration is captured right before the response headers are sent, i.e. on the first body write or flush,
+// or after the handler chain returns if it didn't write a body.
+func ServerTiming() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		withBeforeHeaders(c, func(w gin.ResponseWriter) {
+			dur := float64(time.Since(start)) / float64(time.Millisecond)
+			w.Header().Add("Server-Timing", "app;dur="+strconv.FormatFloat(dur, 'f', -1, 64))
+		})
+	}
+}
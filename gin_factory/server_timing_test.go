@@ -0,0 +1,76 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerTiming(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	gf := NewGinFactory()
+	gf.AddMiddleware(ServerTiming())
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/json", func(c *gin.Context) {
+			time.Sleep(5 * time.Millisecond)
+			c.JSON(http.StatusOK, gin.H{"status": "ok"})
+		})
+		r.GET("/stream", func(c *gin.Context) {
+			c.String(http.StatusOK, "first")
+			c.Writer.Flush()
+			time.Sleep(5 * time.Millisecond)
+			c.String(http.StatusOK, "second")
+		})
+		r.GET("/empty", func(c *gin.Context) {
+			c.Status(http.StatusNoContent)
+		})
+	})
+	r := gf.CreateRouter()
+
+	parse := func(t *testing.T, w *httptest.ResponseRecorder) float64 {
+		values := w.Header().Values("Server-Timing")
+		require.Len(t, values, 1, "Server-Timing header should be set exactly once")
+
+		dur, ok := strings.CutPrefix(values[0], "app;dur=")
+		require.True(t, ok, "Server-Timing header should report the app metric")
+
+		ms, err := strconv.ParseFloat(dur, 64)
+		require.NoError(t, err, "Server-Timing duration should be a number")
+		return ms
+	}
+
+	t.Run("json response", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/json", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, "Response status should be OK")
+		assert.GreaterOrEqual(t, parse(t, w), 5.0, "Duration should cover the handler")
+	})
+
+	t.Run("captured before body is flushed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/stream", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, "firstsecond", w.Body.String(), "Body should be written in full")
+		assert.Less(t, parse(t, w), 5.0, "Duration should be captured at the first write")
+	})
+
+	t.Run("no body", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/empty", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code, "Response status should be No Content")
+		assert.Greater(t, parse(t, w), 0.0, "Duration should be positive")
+	})
+}
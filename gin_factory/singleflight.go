@@ -0,0 +1,118 @@
+package gin_factory
+
+import (
+	"bytes"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/sync/singleflight"
+)
+
+// SingleFlight returns a middleware collapsing concurrent requests with the same key into one handler run.
+// The first request runs the handler chain while its response is buffered; requests arriving with the same key
+// before it completes wait for it and are served a copy of the buffered status, headers and body.
+//
+// Only successful cacheable responses (status 200 without "Cache-Control: no-store" or "private") are shared;
+// otherwise waiting requests run the handler chain themselves. Requests for which keyFn returns an empty string
+// bypass deduplication. If keyFn is nil, GET requests are keyed by their request URI together with their
+// Authorization and Cookie headers, and other methods are bypassed.
+//
+// Requests with the same key are served the same response, whoever sent them: keyFn must include everything
+// the response depends on besides the URI, such as the authenticated user, or concurrent requests of different
+// users get each other's responses unless the handler marks them "Cache-Control: private".
+func SingleFlight(keyFn func(c *gin.Context) string) gin.HandlerFunc {
+	if keyFn == nil {
+		keyFn = defaultSingleFlightKey
+	}
+
+	var group singleflight.Group
+
+	return func(c *gin.Context) {
+		key := keyFn(c)
+		if key == "" {
+			c.Next()
+			return
+		}
+
+		leader := false
+		v, _, _ := group.Do(key, func() (any, error) {
+			leader = true
+
+			rec := &recordingWriter{ResponseWriter: c.Writer}
+			c.Writer = rec
+			defer func() { c.Writer = rec.ResponseWriter }()
+
+			c.Next()
+
+			return &sharedResponse{
+				status: c.Writer.Status(),
+				header: c.Writer.Header().Clone(),
+				body:   rec.body.Bytes(),
+			}, nil
+		})
+		if leader {
+			return
+		}
+
+		resp := v.(*sharedResponse)
+		if !resp.cacheable() {
+			c.Next()
+			return
+		}
+
+		for name, values := range resp.header {
+			c.Writer.Header()[name] = slices.Clone(values)
+		}
+		c.Writer.WriteHeader(resp.status)
+		_, _ = c.Writer.Write(resp.body)
+		c.Abort()
+	}
+}
+
+// defaultSingleFlightKey keys GET requests by their request URI and credentials,
+// so responses are never shared across different Authorization or Cookie headers.
+func defaultSingleFlightKey(c *gin.Context) string {
+	if c.Request.Method != http.MethodGet {
+		return ""
+	}
+	return strings.Join([]string{
+		requestURI(c.Request),
+		strings.Join(c.Request.Header.Values("Authorization"), "\n"),
+		strings.Join(c.Request.Header.Values("Cookie"), "\n"),
+	}, "\x00")
+}
+
+// sharedResponse is a buffered response served to duplicate requests.
+type sharedResponse struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+// cacheable reports whether the response may be shared with other requests.
+func (r *sharedResponse) cacheable() bool {
+	if r.status != http.StatusOK {
+		return false
+	}
+
+	cc := strings.ToLower(r.header.Get("Cache-Control"))
+	return !strings.Contains(cc, "no-store") && !strings.Contains(cc, "private")
+}
+
+// recordingWriter is a gin.ResponseWriter keeping a copy of the written body.
+type recordingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *recordingWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *recordingWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
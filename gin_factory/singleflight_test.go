@@ -0,0 +1,138 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSingleFlight(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	const concurrency = 10
+
+	var calls atomic.Int32
+	gf := NewGinFactory()
+	gf.AddMiddleware(SingleFlight(nil))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/expensive", func(c *gin.Context) {
+			calls.Add(1)
+			time.Sleep(100 * time.Millisecond)
+			c.Header("X-Result", "computed")
+			c.String(http.StatusOK, "result")
+		})
+		r.GET("/failing", func(c *gin.Context) {
+			calls.Add(1)
+			time.Sleep(100 * time.Millisecond)
+			c.String(http.StatusInternalServerError, "failed")
+		})
+		r.GET("/whoami", func(c *gin.Context) {
+			calls.Add(1)
+			time.Sleep(100 * time.Millisecond)
+			c.String(http.StatusOK, c.GetHeader("Authorization")+c.GetHeader("Cookie"))
+		})
+		r.GET("/private", func(c *gin.Context) {
+			calls.Add(1)
+			time.Sleep(100 * time.Millisecond)
+			c.Header("Cache-Control", "private")
+			c.String(http.StatusOK, "mine")
+		})
+	})
+	r := gf.CreateRouter()
+
+	fire := func(path string, header ...func(i int, h http.Header)) []*httptest.ResponseRecorder {
+		recorders := make([]*httptest.ResponseRecorder, concurrency)
+		start := make(chan struct{})
+
+		var wg sync.WaitGroup
+		for i := range recorders {
+			recorders[i] = httptest.NewRecorder()
+			wg.Add(1)
+			req, _ := http.NewRequest(http.MethodGet, path, nil)
+			for _, fn := range header {
+				fn(i, req.Header)
+			}
+			go func(w *httptest.ResponseRecorder) {
+				defer wg.Done()
+				<-start
+				r.ServeHTTP(w, req)
+			}(recorders[i])
+		}
+		close(start)
+		wg.Wait()
+
+		return recorders
+	}
+
+	t.Run("identical requests share the response", func(t *testing.T) {
+		calls.Store(0)
+
+		for _, w := range fire("/expensive") {
+			assert.Equal(t, http.StatusOK, w.Code, "Response status should be OK")
+			assert.Equal(t, "result", w.Body.String(), "Response body should be shared")
+			assert.Equal(t, "computed", w.Header().Get("X-Result"), "Response headers should be shared")
+		}
+		assert.Equal(t, int32(1), calls.Load(), "Handler should run once")
+	})
+
+	t.Run("different credentials don't share the response", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			header string
+		}{
+			{"authorization", "Authorization"},
+			{"cookie", "Cookie"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				calls.Store(0)
+
+				credentials := []string{"user-a", "user-b"}
+				recorders := fire("/whoami", func(i int, h http.Header) {
+					h.Set(tt.header, credentials[i%2])
+				})
+				for i, w := range recorders {
+					assert.Equal(t, http.StatusOK, w.Code, "Response status should be OK")
+					assert.Equal(t, credentials[i%2], w.Body.String(), "Response should belong to the caller")
+				}
+				assert.Equal(t, int32(2), calls.Load(), "Handler should run once per credential")
+			})
+		}
+	})
+
+	t.Run("failed response is not shared", func(t *testing.T) {
+		calls.Store(0)
+
+		for _, w := range fire("/failing") {
+			assert.Equal(t, http.StatusInternalServerError, w.Code, "Response status should be Internal Server Error")
+		}
+		assert.Equal(t, int32(concurrency), calls.Load(), "Handler should run for every request")
+	})
+
+	t.Run("private response is not shared", func(t *testing.T) {
+		calls.Store(0)
+
+		for _, w := range fire("/private") {
+			assert.Equal(t, "mine", w.Body.String(), "Response body should be served")
+		}
+		assert.Equal(t, int32(concurrency), calls.Load(), "Handler should run for every request")
+	})
+
+	t.Run("sequential requests run separately", func(t *testing.T) {
+		calls.Store(0)
+
+		for range 2 {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/expensive", nil)
+			r.ServeHTTP(w, req)
+		}
+		assert.Equal(t, int32(2), calls.Load(), "Handler should run for each non-overlapping request")
+	})
+}
@@ -0,0 +1,105 @@
+package gin_factory
+
+import (
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SLOConfig configures the error budget SLOGuard enforces for a route.
+type SLOConfig struct {
+	// Window is the number of most recent requests the error rate is computed over.
+	Window int
+	// MaxErrorRate is the highest tolerated fraction of failed requests within the window, e.g. 0.01.
+	MaxErrorRate float64
+	// OnBreach is invoked once each time the error rate exceeds MaxErrorRate after being within the budget.
+	// If nil, the breach is logged at warn level through slog.Default.
+	OnBreach func(route string, errorRate float64)
+}
+
+// sloWindow is the rolling window of request outcomes of a single route.
+type sloWindow struct {
+	mtx      sync.Mutex
+	cfg      SLOConfig
+	outcomes []bool // true = failure; a ring buffer of cfg.Window entries
+	next     int
+	count    int
+	failures int
+	breached bool
+}
+
+// SLOGuard returns a middleware maintaining a rolling window of successful and failed requests
+// for each route template in cfg (as returned by c.FullPath(), e.g. "/users/:id").
+// A request fails if it is answered with a 5xx status. Once the window is full and its error rate
+// exceeds the route's MaxErrorRate, OnBreach is invoked; it fires again only after the error rate
+// has dropped back within the budget. Routes missing from cfg or with a non-positive Window are ignored.
+func SLOGuard(cfg map[string]SLOConfig) gin.HandlerFunc {
+	windows := make(map[string]*sloWindow, len(cfg))
+	for route, c := range cfg {
+		if c.Window <= 0 {
+			continue
+		}
+		if c.OnBreach == nil {
+			c.OnBreach = logSLOBreach
+		}
+		windows[route] = &sloWindow{cfg: c, outcomes: make([]bool, c.Window)}
+	}
+
+	return func(c *gin.Context) {
+		c.Next()
+
+		route := c.FullPath()
+		w, ok := windows[route]
+		if !ok {
+			return
+		}
+
+		if rate, breached := w.record(c.Writer.Status() >= http.StatusInternalServerError); breached {
+			w.cfg.OnBreach(route, rate)
+		}
+	}
+}
+
+// record adds the outcome of a request to the window and reports whether it caused a new breach.
+func (w *sloWindow) record(failed bool) (float64, bool) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	if w.count == len(w.outcomes) {
+		if w.outcomes[w.next] {
+			w.failures--
+		}
+	} else {
+		w.count++
+	}
+	w.outcomes[w.next] = failed
+	if failed {
+		w.failures++
+	}
+	w.next = (w.next + 1) % len(w.outcomes)
+
+	if w.count < len(w.outcomes) {
+		return 0, false
+	}
+
+	rate := float64(w.failures) / float64(w.count)
+	if rate <= w.cfg.MaxErrorRate {
+		w.breached = false
+		return rate, false
+	}
+	if w.breached {
+		return rate, false
+	}
+	w.breached = true
+	return rate, true
+}
+
+// logSLOBreach is the default SLOConfig.OnBreach callback.
+func logSLOBreach(route string, errorRate float64) {
+	slog.Warn("error budget burned",
+		"route", route,
+		"error_rate", errorRate,
+	)
+}
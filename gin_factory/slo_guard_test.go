@@ -0,0 +1,95 @@
+package gin_factory
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSLOGuard(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var (
+		breaches []string
+		rates    []float64
+		fail     bool
+	)
+	gf := NewGinFactory()
+	gf.AddMiddleware(SLOGuard(map[string]SLOConfig{
+		"/users/:id": {
+			Window:       4,
+			MaxErrorRate: 0.25,
+			OnBreach: func(route string, errorRate float64) {
+				breaches = append(breaches, route)
+				rates = append(rates, errorRate)
+			},
+		},
+	}))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/users/:id", func(c *gin.Context) {
+			if fail {
+				c.Status(http.StatusInternalServerError)
+				return
+			}
+			c.Status(http.StatusOK)
+		})
+		r.GET("/other", func(c *gin.Context) {
+			c.Status(http.StatusInternalServerError)
+		})
+	})
+	r := gf.CreateRouter()
+
+	serve := func(path string, failing bool, n int) {
+		fail = failing
+		for range n {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, path, nil)
+			r.ServeHTTP(w, req)
+		}
+	}
+
+	serve("/users/1", false, 3)
+	serve("/users/2", true, 1)
+	assert.Empty(t, breaches, "Error rate within the budget should not be reported")
+
+	serve("/users/3", true, 3)
+	assert.Equal(t, []string{"/users/:id"}, breaches, "Breach should be reported exactly once")
+	assert.Equal(t, []float64{0.5}, rates, "Breach should report the error rate")
+
+	serve("/other", true, 10)
+	assert.Len(t, breaches, 1, "Routes without a config should be ignored")
+
+	serve("/users/4", false, 4)
+	serve("/users/5", true, 2)
+	assert.Len(t, breaches, 2, "A new breach should be reported after recovering")
+}
+
+func TestSLOGuardDefaultCallback(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	out := &bytes.Buffer{}
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(slog.New(slog.NewJSONHandler(out, nil)))
+
+	gf := NewGinFactory()
+	gf.AddMiddleware(SLOGuard(map[string]SLOConfig{"/fail": {Window: 1}}))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/fail", func(c *gin.Context) {
+			c.Status(http.StatusServiceUnavailable)
+		})
+	})
+	r := gf.CreateRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/fail", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Contains(t, out.String(), `"level":"WARN"`, "Default callback should log at warn level")
+	assert.Contains(t, out.String(), `"msg":"error budget burned"`, "Default callback should log the breach")
+	assert.Contains(t, out.String(), `"route":"/fail"`, "Default callback should log the route")
+}
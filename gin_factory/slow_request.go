@@ -0,0 +1,38 @@
+package gin_factory
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SlowRequest returns a middleware that measures the duration of each request and invokes report
+// only when it exceeds threshold. If report is nil, slow requests are logged at warn level
+// through slog.Default, with the duration in milliseconds.
+func SlowRequest(threshold time.Duration, report func(c *gin.Context, took time.Duration)) gin.HandlerFunc {
+	if report == nil {
+		report = logSlowRequest
+	}
+
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		if took := time.Since(start); took > threshold {
+			report(c, took)
+		}
+	}
+}
+
+// logSlowRequest is the default SlowRequest reporter.
+func logSlowRequest(c *gin.Context, took time.Duration) {
+	slog.Warn("slow request",
+		"method", c.Request.Method,
+		"path", c.Request.URL.Path,
+		"route", c.FullPath(),
+		"status", c.Writer.Status(),
+		slog.Float64("took", float64(took)/float64(time.Millisecond)),
+	)
+}
@@ -0,0 +1,86 @@
+package gin_factory
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlowRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var (
+		reported bool
+		took     time.Duration
+	)
+	gf := NewGinFactory()
+	gf.AddMiddleware(SlowRequest(20*time.Millisecond, func(c *gin.Context, d time.Duration) {
+		reported = true
+		took = d
+	}))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/slow", func(c *gin.Context) {
+			time.Sleep(30 * time.Millisecond)
+			c.String(http.StatusOK, "slow")
+		})
+		r.GET("/fast", func(c *gin.Context) {
+			c.String(http.StatusOK, "fast")
+		})
+	})
+	r := gf.CreateRouter()
+
+	t.Run("slow handler", func(t *testing.T) {
+		reported = false
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/slow", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, "Response status should be OK")
+		assert.True(t, reported, "Slow request should be reported")
+		assert.GreaterOrEqual(t, took, 30*time.Millisecond, "Reported duration should cover the handler")
+	})
+
+	t.Run("fast handler", func(t *testing.T) {
+		reported = false
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/fast", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, "Response status should be OK")
+		assert.False(t, reported, "Fast request should not be reported")
+	})
+}
+
+func TestSlowRequestDefaultReporter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	out := &bytes.Buffer{}
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(slog.New(slog.NewJSONHandler(out, nil)))
+
+	gf := NewGinFactory()
+	gf.AddMiddleware(SlowRequest(0, nil))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/users/:id", func(c *gin.Context) {
+			time.Sleep(time.Millisecond)
+			c.Status(http.StatusOK)
+		})
+	})
+	r := gf.CreateRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/users/42", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Contains(t, out.String(), `"level":"WARN"`, "Default reporter should log at warn level")
+	assert.Contains(t, out.String(), `"msg":"slow request"`, "Default reporter should log the slow request")
+	assert.Contains(t, out.String(), `"route":"/users/:id"`, "Default reporter should log the route")
+}
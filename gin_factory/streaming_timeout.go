@@ -0,0 +1,70 @@
+package gin_factory
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// errStreamIdle is the cause of the request context cancellation by StreamingTimeout.
+var errStreamIdle = errors.New("no response bytes written within the idle timeout")
+
+// StreamingTimeout returns a middleware enforcing an idle-write timeout instead of a total deadline:
+// the request context is cancelled only if the handler writes no response bytes for idle,
+// so long-lived streams (e.g. server-sent events) keep running as long as they make progress.
+// Every write or flush restarts the idle timer; the response isn't buffered.
+//
+// Handlers observe the cancellation through c.Request.Context() and are expected to return;
+// context.Cause reports the idle timeout. If the handler returns without having written anything,
+// the request is aborted with http.StatusGatewayTimeout and a JSON error body.
+// An idle of 0 or below disables the timeout.
+func StreamingTimeout(idle time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if idle <= 0 {
+			c.Next()
+			return
+		}
+
+		ctx, cancel := context.WithCancelCause(c.Request.Context())
+		defer cancel(nil)
+
+		timer := time.AfterFunc(idle, func() { cancel(errStreamIdle) })
+		defer timer.Stop()
+
+		iw := &idleWriter{ResponseWriter: c.Writer, timer: timer, idle: idle}
+		c.Writer = iw
+		c.Request = c.Request.WithContext(ctx)
+		defer func() { c.Writer = iw.ResponseWriter }()
+
+		c.Next()
+
+		if errors.Is(context.Cause(ctx), errStreamIdle) && !c.Writer.Written() {
+			c.AbortWithStatusJSON(http.StatusGatewayTimeout, gin.H{"error": "response timed out"})
+		}
+	}
+}
+
+// idleWriter is a gin.ResponseWriter restarting the idle timer on every write or flush.
+type idleWriter struct {
+	gin.ResponseWriter
+	timer *time.Timer
+	idle  time.Duration
+}
+
+func (w *idleWriter) Write(data []byte) (int, error) {
+	w.timer.Reset(w.idle)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *idleWriter) WriteString(s string) (int, error) {
+	w.timer.Reset(w.idle)
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *idleWriter) Flush() {
+	w.timer.Reset(w.idle)
+	w.ResponseWriter.Flush()
+}
@@ -0,0 +1,76 @@
+package gin_factory
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamingTimeout(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	gf := NewGinFactory()
+	gf.AddMiddleware(StreamingTimeout(50 * time.Millisecond))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/stream", func(c *gin.Context) {
+			for i := 0; i < 10; i++ {
+				select {
+				case <-c.Request.Context().Done():
+					return
+				case <-time.After(15 * time.Millisecond):
+					_, _ = c.Writer.WriteString("data\n")
+					c.Writer.Flush()
+				}
+			}
+		})
+		r.GET("/stall", func(c *gin.Context) {
+			select {
+			case <-c.Request.Context().Done():
+				assert.True(t, errors.Is(context.Cause(c.Request.Context()), errStreamIdle), "Cause should be the idle timeout")
+			case <-time.After(time.Second):
+				c.String(http.StatusOK, "too late")
+			}
+		})
+		r.GET("/stall-after-write", func(c *gin.Context) {
+			_, _ = c.Writer.WriteString("partial")
+			<-c.Request.Context().Done()
+		})
+	})
+	r := gf.CreateRouter()
+
+	t.Run("periodic writes pass", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/stream", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, "Response status should be OK")
+		assert.Equal(t, 10, len(w.Body.String())/len("data\n"), "Stream should run past the idle timeout")
+	})
+
+	t.Run("stalled handler aborts", func(t *testing.T) {
+		start := time.Now()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/stall", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusGatewayTimeout, w.Code, "Stalled request should time out")
+		assert.JSONEq(t, `{"error":"response timed out"}`, w.Body.String(), "Response body should be a JSON error")
+		assert.Less(t, time.Since(start), 500*time.Millisecond, "Request should be cut at the idle timeout")
+	})
+
+	t.Run("stalled stream stops", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/stall-after-write", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, "Status of a started stream should be kept")
+		assert.Equal(t, "partial", w.Body.String(), "Written data should be kept")
+	})
+}
@@ -0,0 +1,25 @@
+package gin_factory
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// StripHeaders returns a middleware removing the named headers, e.g. "Server" or "X-Powered-By",
+// from the response right before it is sent, after the handlers had the chance to set them.
+// Header names are case-insensitive.
+func StripHeaders(names ...string) gin.HandlerFunc {
+	canonical := make([]string, len(names))
+	for i, name := range names {
+		canonical[i] = http.CanonicalHeaderKey(name)
+	}
+
+	return func(c *gin.Context) {
+		withBeforeHeaders(c, func(w gin.ResponseWriter) {
+			for _, name := range canonical {
+				w.Header().Del(name)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStripHeaders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	gf := NewGinFactory()
+	gf.AddMiddleware(StripHeaders("server", "X-Powered-By"))
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/test", func(c *gin.Context) {
+			c.Header("Server", "gin")
+			c.Header("X-Powered-By", "go")
+			c.Header("X-Request-Id", "42")
+			c.String(http.StatusOK, "test handler")
+		})
+		r.GET("/empty", func(c *gin.Context) {
+			c.Header("Server", "gin")
+			c.Status(http.StatusNoContent)
+		})
+	})
+	r := gf.CreateRouter()
+
+	t.Run("response with body", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, "Response status should be OK")
+		assert.Empty(t, w.Header().Values("Server"), "Server header should be stripped")
+		assert.Empty(t, w.Header().Values("X-Powered-By"), "X-Powered-By header should be stripped")
+		assert.Equal(t, "42", w.Header().Get("X-Request-Id"), "Other headers should be kept")
+		assert.Equal(t, "test handler", w.Body.String(), "Response body should match handler output")
+	})
+
+	t.Run("response without body", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/empty", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code, "Response status should be No Content")
+		assert.Empty(t, w.Header().Values("Server"), "Server header should be stripped")
+	})
+}
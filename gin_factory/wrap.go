@@ -0,0 +1,21 @@
+package gin_factory
+
+import "github.com/gin-gonic/gin"
+
+// WrapAllHandlers ensures mw runs for every route registered with the GinFactory, including routes
+// added by handler callbacks from other packages that can't be trusted to add it themselves.
+// Unlike middleware added with AddMiddleware, mw runs after the rest of the middleware, right before
+// the route handlers, and only for requests matching a route, so c.FullPath() is always set.
+// Requests answered by the 404 and 405 handlers skip it. Wrappers run in the order they are added.
+func (g *GinFactory) WrapAllHandlers(mw gin.HandlerFunc) {
+	g.routeWrapper = append(g.routeWrapper, mw)
+}
+
+// matchedRouteOnly returns a middleware executing mw only for requests matching a registered route.
+func matchedRouteOnly(mw gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.FullPath() != "" {
+			mw(c)
+		}
+	}
+}
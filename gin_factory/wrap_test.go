@@ -0,0 +1,75 @@
+package gin_factory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrapAllHandlers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var (
+		order  []string
+		routes []string
+	)
+	gf := NewGinFactory()
+	gf.WrapAllHandlers(func(c *gin.Context) {
+		order = append(order, "wrapper")
+		routes = append(routes, c.FullPath())
+		if c.GetHeader("Authorization") == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		}
+	})
+	gf.AddMiddleware(func(c *gin.Context) {
+		order = append(order, "middleware")
+	})
+	gf.AddHandlers(func(r *gin.Engine) {
+		r.GET("/users/:id", func(c *gin.Context) {
+			order = append(order, "handler")
+			c.Status(http.StatusOK)
+		})
+	}, func(r *gin.Engine) {
+		api := r.Group("/api")
+		api.POST("/orders", func(c *gin.Context) {
+			order = append(order, "handler")
+			c.Status(http.StatusCreated)
+		})
+	})
+	r := gf.CreateRouter()
+
+	tests := []struct {
+		name          string
+		method        string
+		path          string
+		authorized    bool
+		expectedCode  int
+		expectedRoute []string
+		expectedOrder []string
+	}{
+		{"first route", http.MethodGet, "/users/1", true, http.StatusOK, []string{"/users/:id"}, []string{"middleware", "wrapper", "handler"}},
+		{"group route", http.MethodPost, "/api/orders", true, http.StatusCreated, []string{"/api/orders"}, []string{"middleware", "wrapper", "handler"}},
+		{"rejected by wrapper", http.MethodGet, "/users/1", false, http.StatusUnauthorized, []string{"/users/:id"}, []string{"middleware", "wrapper"}},
+		{"unmatched route", http.MethodGet, "/missing", false, http.StatusNotFound, nil, []string{"middleware"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order, routes = nil, nil
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tt.method, tt.path, nil)
+			if tt.authorized {
+				req.Header.Set("Authorization", "Bearer token")
+			}
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code, "Response status should match")
+			assert.Equal(t, tt.expectedRoute, routes, "Wrapper should see the matched route")
+			assert.Equal(t, tt.expectedOrder, order, "Wrapper should run after middleware and before the handler")
+		})
+	}
+}
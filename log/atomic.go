@@ -0,0 +1,282 @@
+package log
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	atomicMtx    sync.Mutex  // serializes ConfigureAtomic and Swap calls
+	collecting   atomic.Bool // true while ConfigureAtomic applies options
+	errMtx       sync.Mutex
+	optionErrors []error
+	staging      bool        // true while ConfigureAtomic or Swap apply options, guarded by mtx
+	released     []io.Closer // resources replaced while staging, closed once the configuration is stored
+	acquired     []io.Closer // resources opened while staging, closed if the configuration is discarded
+)
+
+// ConfigureAtomic applies the provided LoggingOptions like Configure, but all-or-nothing:
+// if any option is invalid (e.g. an unknown log level passed to WithLogLevel or a nil output passed to WithOutput),
+// the previous configuration is kept and the validation errors are returned
+// instead of silently falling back to defaults as Configure does.
+//
+// Options are applied to a staged configuration, which replaces the global logger only if all of them are valid,
+// so records emitted concurrently never observe a partially applied or discarded configuration.
+// Resources opened by discarded options, such as files opened by WithOutputTarget, are closed.
+// Side effects outside the logger itself, such as flushing a buffered output, aren't rolled back.
+func ConfigureAtomic(options ...LoggingOptions) error {
+	atomicMtx.Lock()
+	defer atomicMtx.Unlock()
+
+	saved := saveState()
+
+	errMtx.Lock()
+	optionErrors = nil
+	errMtx.Unlock()
+
+	collecting.Store(true)
+	stage := beginStaging()
+	Configure(options...)
+	collecting.Store(false)
+
+	errMtx.Lock()
+	err := errors.Join(optionErrors...)
+	optionErrors = nil
+	errMtx.Unlock()
+
+	if err != nil {
+		stage.discard(saved)
+		return err
+	}
+
+	stage.store()
+	return nil
+}
+
+// Swap applies the provided LoggingOptions like Configure, but the global logger is replaced once,
+// with a single atomic store, after all options are applied: records emitted concurrently are handled
+// either entirely by the previous configuration or entirely by the new one, never by a half-applied one,
+// e.g. when reloading the configuration at runtime. Options are applied on top of the current configuration.
+func Swap(options ...LoggingOptions) {
+	atomicMtx.Lock()
+	defer atomicMtx.Unlock()
+
+	stage := beginStaging()
+	Configure(options...)
+	stage.store()
+}
+
+// stagedConfig tracks a configuration staged by ConfigureAtomic or Swap.
+// While staging, options update the package configuration, but storeLogger leaves the global logger untouched.
+type stagedConfig struct {
+	level    *slog.LevelVar
+	output   io.Writer
+	buffered *bufferedWriter
+}
+
+// beginStaging starts staging a configuration. The caller must hold atomicMtx.
+func beginStaging() stagedConfig {
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	// Level changes are staged on a separate LevelVar, as the current one is used by the current logger.
+	// The staged LevelVar is stored along with the new logger, so a published LevelVar is never changed by Swap,
+	// and a record passing the level check of a logger is always handled by that logger.
+	s := stagedConfig{level: logLevel, output: output, buffered: buffered}
+	logLevel = new(slog.LevelVar)
+	logLevel.Set(s.level.Level())
+	staging = true
+
+	return s
+}
+
+// store replaces the global logger with one built from the staged configuration.
+func (s stagedConfig) store() {
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	staging = false
+
+	marker := destinationMarker && !sameWriter(output, s.output)
+	now := time.Now()
+	if marker {
+		emitDestinationMarker(now)
+	}
+
+	storeLogger(output)
+
+	if marker {
+		emitDestinationMarker(now)
+	}
+	if s.buffered != nil && s.buffered != buffered {
+		_ = s.buffered.Flush()
+	}
+
+	for _, c := range released {
+		_ = c.Close()
+	}
+	released, acquired = nil, nil
+}
+
+// discard drops the staged configuration, restoring saved, and closes the resources opened while staging.
+func (s stagedConfig) discard(saved state) {
+	mtx.Lock()
+	staging = false
+	logLevel = s.level
+	for _, c := range acquired {
+		_ = c.Close()
+	}
+	released, acquired = nil, nil
+	mtx.Unlock()
+
+	saved.restore()
+}
+
+// release closes a resource replaced by an option, e.g. the previous syslog connection.
+// While staging, the global logger may still write to it, so it is closed once the configuration is stored.
+// The caller must hold mtx.
+func release(c io.Closer) {
+	if staging {
+		released = append(released, c)
+		return
+	}
+	_ = c.Close()
+}
+
+// acquire records a resource opened by an option, so it is closed if the staged configuration is discarded.
+// The caller must hold mtx.
+func acquire(c io.Closer) {
+	if staging {
+		acquired = append(acquired, c)
+	}
+}
+
+// reportInvalidOption records an invalid option value for ConfigureAtomic.
+// It is a no-op outside ConfigureAtomic, where options fall back to defaults instead.
+func reportInvalidOption(err error) {
+	if !collecting.Load() {
+		return
+	}
+
+	errMtx.Lock()
+	defer errMtx.Unlock()
+	optionErrors = append(optionErrors, err)
+}
+
+// warnFallback writes a warning about an option falling back to a default straight to os.Stderr,
+// so it is neither filtered by the configured level nor lost in an output that doesn't work.
+// It is a no-op inside ConfigureAtomic, which returns the error and discards the fallback instead.
+func warnFallback(msg string, args ...any) {
+	if collecting.Load() {
+		return
+	}
+	slog.New(slog.NewJSONHandler(os.Stderr, nil)).Warn(msg, args...)
+}
+
+// state is a snapshot of the global logger configuration.
+type state struct {
+	logger            *slog.Logger
+	levelVar          *slog.LevelVar
+	level             slog.Level
+	output            io.Writer
+	format            int64
+	maxAttrDepth      int
+	buffered          *bufferedWriter
+	outputFunc        func(r slog.Record) io.Writer
+	lowercaseLevels   bool
+	contextExtractor  func(ctx context.Context) []slog.Attr
+	int64AsString     bool
+	recordHooks       []func(ctx context.Context, r *slog.Record)
+	maxValueLen       int
+	defaultAttrs      []slog.Attr
+	goroutineID       bool
+	dedupWindow       time.Duration
+	nilValue          *string
+	sampling          *samplingConfig
+	sequence          bool
+	gcpFormat         bool
+	recordChannel     chan<- slog.Record
+	destinationMarker bool
+	syslogConn        io.Closer
+	customHandler     slog.Handler
+	targetFile        *os.File
+	targetPath        string
+	healingOutput     io.Writer
+}
+
+// saveState returns a snapshot of the current global logger configuration.
+func saveState() state {
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	return state{
+		logger:            globalLogger.Load(),
+		levelVar:          logLevel,
+		level:             logLevel.Level(),
+		output:            output,
+		format:            handler.Load(),
+		maxAttrDepth:      maxAttrDepth,
+		buffered:          buffered,
+		outputFunc:        outputFunc,
+		lowercaseLevels:   lowercaseLevels,
+		contextExtractor:  contextExtractor,
+		int64AsString:     int64AsString,
+		recordHooks:       recordHooks,
+		maxValueLen:       maxValueLen,
+		defaultAttrs:      defaultAttrs,
+		goroutineID:       goroutineID,
+		dedupWindow:       dedupWindow,
+		nilValue:          nilValue,
+		sampling:          sampling,
+		sequence:          sequence,
+		gcpFormat:         gcpFormat,
+		recordChannel:     recordChannel,
+		destinationMarker: destinationMarker,
+		syslogConn:        syslogConn,
+		customHandler:     customHandler,
+		targetFile:        targetFile,
+		targetPath:        targetPath,
+		healingOutput:     healingOutput,
+	}
+}
+
+// restore replaces the global logger configuration with the snapshot.
+func (s state) restore() {
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	logLevel = s.levelVar
+	logLevel.Set(s.level)
+	output = s.output
+	handler.Store(s.format)
+	maxAttrDepth = s.maxAttrDepth
+	buffered = s.buffered
+	outputFunc = s.outputFunc
+	lowercaseLevels = s.lowercaseLevels
+	contextExtractor = s.contextExtractor
+	int64AsString = s.int64AsString
+	recordHooks = s.recordHooks
+	maxValueLen = s.maxValueLen
+	defaultAttrs = s.defaultAttrs
+	goroutineID = s.goroutineID
+	dedupWindow = s.dedupWindow
+	nilValue = s.nilValue
+	sampling = s.sampling
+	sequence = s.sequence
+	gcpFormat = s.gcpFormat
+	recordChannel = s.recordChannel
+	destinationMarker = s.destinationMarker
+	syslogConn = s.syslogConn
+	customHandler = s.customHandler
+	targetFile = s.targetFile
+	targetPath = s.targetPath
+	healingOutput = s.healingOutput
+	globalLogger.Store(s.logger)
+	globalLevel.Store(logLevel)
+}
@@ -0,0 +1,295 @@
+package log
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestConfigureAtomic(t *testing.T) {
+	defer resetLoggerConf()
+
+	t.Run("valid options applied", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		err := ConfigureAtomic(WithOutput(out), WithTextFormat(), WithLogLevel("info"))
+		require.NoError(t, err)
+
+		assert.Equal(t, slog.LevelInfo, logLevel.Level())
+		assert.Equal(t, out, output)
+
+		Info("applied")
+		assert.Contains(t, out.String(), "msg=applied")
+	})
+
+	t.Run("invalid option retains original config", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		original := &bytes.Buffer{}
+		Configure(WithOutput(original), WithLogLevel("error"))
+		loggerBefore := globalLogger.Load()
+
+		out := &bytes.Buffer{}
+		err := ConfigureAtomic(WithOutput(out), WithTextFormat(), WithLogLevel("verbose"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid log level "verbose"`)
+
+		assert.Equal(t, slog.LevelError, logLevel.Level())
+		assert.Equal(t, original, output)
+		assert.Equal(t, int64(0), handler.Load())
+		assert.Same(t, loggerBefore, globalLogger.Load())
+
+		h := reflect.ValueOf(globalLogger.Load().Handler())
+		if h.Kind() == reflect.Ptr {
+			h = h.Elem()
+		}
+		assert.Equal(t, "JSONHandler", h.Type().Name())
+
+		Error("retained")
+		assert.Contains(t, original.String(), "retained")
+		assert.Empty(t, out.String())
+	})
+
+	t.Run("nil output", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		original := &bytes.Buffer{}
+		Configure(WithOutput(original))
+
+		err := ConfigureAtomic(WithLogLevel("debug"), WithOutput(nil))
+		require.Error(t, err)
+
+		assert.Equal(t, slog.LevelWarn, logLevel.Level())
+		assert.Equal(t, original, output)
+	})
+
+	t.Run("concurrent emits never see a discarded configuration", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		original, discarded := &lockedBuffer{}, &lockedBuffer{}
+		Configure(WithOutput(original), WithLogLevel("warn"))
+
+		stop := make(chan struct{})
+		var wg sync.WaitGroup
+		for range 4 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					select {
+					case <-stop:
+						return
+					default:
+						Info("info")
+						Warn("warn")
+					}
+				}
+			}()
+		}
+
+		for range 200 {
+			err := ConfigureAtomic(WithOutput(discarded), WithTextFormat(), WithLogLevel("info"), WithLogLevel("verbose"))
+			require.Error(t, err)
+		}
+		close(stop)
+		wg.Wait()
+
+		assert.Empty(t, discarded.buf.String(), "expected no record in the output of the discarded configuration")
+		scanner := bufio.NewScanner(&original.buf)
+		for scanner.Scan() {
+			var record map[string]any
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &record), "expected only JSON records in the original output")
+			assert.Equal(t, "WARN", record["level"], "expected info records to be dropped by the original level")
+		}
+	})
+
+	t.Run("resources opened by a discarded configuration are closed", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		original := &bytes.Buffer{}
+		Configure(WithOutput(original))
+
+		path := filepath.Join(t.TempDir(), "app.log")
+		var opened *os.File
+		err := ConfigureAtomic(WithOutputTarget(path), func() {
+			opened, _ = output.(*os.File)
+		}, WithLogLevel("verbose"))
+		require.Error(t, err)
+		require.NotNil(t, opened, "expected the target to be opened while staging")
+
+		assert.Equal(t, original, output)
+		_, err = opened.Write([]byte("x"))
+		assert.ErrorIs(t, err, os.ErrClosed, "expected the file opened by the discarded option to be closed")
+	})
+
+	t.Run("Configure still defaults invalid values", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		Configure(WithLogLevel("debug"), WithLogLevel("verbose"))
+		assert.Equal(t, slog.LevelWarn, logLevel.Level())
+		assert.Empty(t, optionErrors)
+	})
+}
+
+// lockedBuffer is a bytes.Buffer safe for concurrent writes.
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func TestSwap(t *testing.T) {
+	defer resetLoggerConf()
+
+	t.Run("options applied", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		Swap(WithOutput(out), WithTextFormat(), WithLogLevel("info"), WithDefaultAttrs(slog.String("svc", "api")))
+
+		assert.Equal(t, slog.LevelInfo, logLevel.Level())
+		assert.Equal(t, out, output)
+
+		Debug("dropped")
+		Info("applied")
+		assert.NotContains(t, out.String(), "dropped")
+		assert.Contains(t, out.String(), "msg=applied svc=api")
+	})
+
+	t.Run("level copies keep following", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		Configure(WithOutput(out))
+		lg := CopyLoggerAtLevel(slog.LevelInfo)
+
+		Swap(WithLogLevel("error"))
+		lg.Warn("dropped")
+		assert.Empty(t, out.String())
+	})
+
+	t.Run("concurrent emits never see a half-applied configuration", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		jsonOut, textOut := &lockedBuffer{}, &lockedBuffer{}
+		toJSON := []LoggingOptions{WithOutput(jsonOut), WithJSONFormat(), WithLogLevel("info"), WithDefaultAttrs(slog.String("cfg", "json"))}
+		toText := []LoggingOptions{WithOutput(textOut), WithTextFormat(), WithLogLevel("warn"), WithDefaultAttrs(slog.String("cfg", "text"))}
+		Swap(toJSON...)
+
+		stop := make(chan struct{})
+		var wg sync.WaitGroup
+		for range 4 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					select {
+					case <-stop:
+						return
+					default:
+						Info("info")
+						Warn("warn")
+					}
+				}
+			}()
+		}
+
+		for i := range 200 {
+			if i%2 == 0 {
+				Swap(toText...)
+			} else {
+				Swap(toJSON...)
+			}
+		}
+		close(stop)
+		wg.Wait()
+
+		scanner := bufio.NewScanner(&jsonOut.buf)
+		for scanner.Scan() {
+			var record map[string]any
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &record), "expected only JSON records in the JSON output")
+			assert.Equal(t, "json", record["cfg"])
+		}
+
+		scanner = bufio.NewScanner(&textOut.buf)
+		for scanner.Scan() {
+			line := scanner.Text()
+			require.True(t, strings.HasPrefix(line, "time="), "expected only text records in the text output: %s", line)
+			assert.True(t, strings.HasSuffix(line, "cfg=text"), "expected the text attrs: %s", line)
+			assert.Contains(t, line, "level=WARN", "expected info records to be dropped by the text level")
+		}
+	})
+
+	t.Run("records are filtered by the level of the logger handling them", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		jsonOut, textOut := &lockedBuffer{}, &lockedBuffer{}
+		toJSON := []LoggingOptions{WithOutput(jsonOut), WithJSONFormat(), WithLogLevel("info")}
+		toText := []LoggingOptions{WithOutput(textOut), WithTextFormat(), WithLogLevel("warn")}
+		Swap(toJSON...)
+
+		stop := make(chan struct{})
+		var wg sync.WaitGroup
+		for range 16 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					select {
+					case <-stop:
+						return
+					default:
+						Info("info")
+					}
+				}
+			}()
+		}
+
+		for i := range 5000 {
+			if i%2 == 0 {
+				Swap(toText...)
+			} else {
+				Swap(toJSON...)
+			}
+		}
+		close(stop)
+		wg.Wait()
+
+		assert.Empty(t, textOut.buf.String(), "expected info records never to reach the warn-level text output")
+		scanner := bufio.NewScanner(&jsonOut.buf)
+		for scanner.Scan() {
+			var record map[string]any
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &record), "expected only JSON records in the JSON output")
+			assert.Equal(t, "INFO", record["level"])
+		}
+	})
+
+	t.Run("level copies follow the swapped level", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		Configure(WithOutput(out), WithLogLevel("error"))
+		lg := CopyLoggerAtLevel(slog.LevelDebug)
+
+		Swap(WithLogLevel("info"))
+		lg.Debug("dropped")
+		lg.Info("kept")
+		assert.NotContains(t, out.String(), "dropped")
+		assert.Contains(t, out.String(), `"msg":"kept"`, "expected the copy to follow the level of the new logger")
+	})
+}
@@ -0,0 +1,127 @@
+package log
+
+import (
+	"log/slog"
+	"reflect"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+// Duration returns a slog.Attr rendering d as a number of milliseconds.
+// Fractions of a millisecond are preserved, so 1500µs is rendered as 1.5.
+func Duration(key string, d time.Duration) slog.Attr {
+	return slog.Float64(key, float64(d)/float64(time.Millisecond))
+}
+
+// Bytes returns a slog.Attr rendering n as a numeric byte count.
+func Bytes(key string, n int64) slog.Attr {
+	return slog.Int64(key, n)
+}
+
+// maxSafeInteger is the largest integer that float64-based JSON parsers (e.g. JavaScript) represent exactly.
+const maxSafeInteger = 1<<53 - 1
+
+// WithInt64AsString renders integer attribute values outside the range exactly representable by float64
+// (greater than 2^53-1 in magnitude) as strings, so JSON consumers parsing numbers as float64 don't lose precision.
+// Smaller values stay numeric.
+func WithInt64AsString() LoggingOptions {
+	return func() {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		int64AsString = true
+		storeLogger(output)
+	}
+}
+
+// largeIntToString is a ReplaceAttr function rendering integers beyond maxSafeInteger as strings.
+func largeIntToString(_ []string, a slog.Attr) slog.Attr {
+	switch a.Value.Kind() {
+	case slog.KindInt64:
+		if v := a.Value.Int64(); v > maxSafeInteger || v < -maxSafeInteger {
+			a.Value = slog.StringValue(strconv.FormatInt(v, 10))
+		}
+	case slog.KindUint64:
+		if v := a.Value.Uint64(); v > maxSafeInteger {
+			a.Value = slog.StringValue(strconv.FormatUint(v, 10))
+		}
+	}
+	return a
+}
+
+// WithMaxValueLen truncates string attribute values longer than n bytes, appending an ellipsis and the original length,
+// e.g. "...(12345 bytes)". Truncation never splits a UTF-8 sequence. Non-string values and the message are untouched.
+// A non-positive n disables truncation.
+func WithMaxValueLen(n int) LoggingOptions {
+	return func() {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		maxValueLen = max(n, 0)
+		storeLogger(output)
+	}
+}
+
+// truncateValue returns a ReplaceAttr function truncating string values longer than limit bytes.
+func truncateValue(limit int) func(groups []string, a slog.Attr) slog.Attr {
+	return func(groups []string, a slog.Attr) slog.Attr {
+		if a.Value.Kind() != slog.KindString || (len(groups) == 0 && a.Key == slog.MessageKey) {
+			return a
+		}
+
+		s := a.Value.String()
+		if len(s) <= limit {
+			return a
+		}
+
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		a.Value = slog.StringValue(s[:cut] + "...(" + strconv.Itoa(len(s)) + " bytes)")
+		return a
+	}
+}
+
+// DropNil passed to WithNilValue removes attributes with nil values instead of replacing them.
+const DropNil = "\x00drop"
+
+// WithNilValue renders nil attribute values, including nil pointers, maps, slices and errors, as repr,
+// so JSON and text output agree on a representation consumers accept, e.g. "" or "-".
+// Pass DropNil to remove such attributes instead. If provided multiple times, the latest wins.
+func WithNilValue(repr string) LoggingOptions {
+	return func() {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		nilValue = &repr
+		storeLogger(output)
+	}
+}
+
+// replaceNil returns a ReplaceAttr function replacing nil values with repr, or dropping them for DropNil.
+func replaceNil(repr string) func(groups []string, a slog.Attr) slog.Attr {
+	return func(_ []string, a slog.Attr) slog.Attr {
+		if a.Value.Kind() != slog.KindAny || !isNil(a.Value.Any()) {
+			return a
+		}
+		if repr == DropNil {
+			return slog.Attr{}
+		}
+		a.Value = slog.StringValue(repr)
+		return a
+	}
+}
+
+// isNil reports whether v is nil or a nil value of a nilable kind.
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan, reflect.Interface:
+		return rv.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAttrHelpers(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithJSONFormat())
+
+	Error("attrs", Duration("took", 1500*time.Microsecond), Bytes("size", 4096))
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(out.Bytes(), &record))
+
+	assert.Equal(t, 1.5, record["took"])
+	assert.Equal(t, float64(4096), record["size"])
+	assert.Contains(t, out.String(), "\"took\":1.5")
+	assert.Contains(t, out.String(), "\"size\":4096")
+}
+
+func TestWithInt64AsString(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithInt64AsString())
+
+	Error("ids",
+		slog.Int64("max", math.MaxInt64),
+		slog.Int64("min", math.MinInt64),
+		slog.Uint64("umax", math.MaxUint64),
+		slog.Int64("safe", 1<<53-1),
+		slog.Int("small", 42),
+		slog.Group("g", slog.Int64("nested", math.MaxInt64)),
+	)
+
+	assert.Contains(t, out.String(), `"max":"9223372036854775807"`)
+	assert.Contains(t, out.String(), `"min":"-9223372036854775808"`)
+	assert.Contains(t, out.String(), `"umax":"18446744073709551615"`)
+	assert.Contains(t, out.String(), `"safe":9007199254740991`)
+	assert.Contains(t, out.String(), `"small":42`)
+	assert.Contains(t, out.String(), `"g":{"nested":"9223372036854775807"}`)
+}
+
+func TestWithMaxValueLen(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithMaxValueLen(5))
+
+	Error(strings.Repeat("m", 10),
+		slog.String("long", strings.Repeat("a", 12345)),
+		slog.String("short", "abc"),
+		slog.String("exact", "abcde"),
+		slog.String("utf8", "aaaaéb"),
+		slog.Int("num", 1234567890),
+		slog.Group("g", slog.String("nested", "abcdefgh")),
+	)
+
+	assert.Contains(t, out.String(), `"long":"aaaaa...(12345 bytes)"`)
+	assert.Contains(t, out.String(), `"short":"abc"`)
+	assert.Contains(t, out.String(), `"exact":"abcde"`)
+	assert.Contains(t, out.String(), `"utf8":"aaaa...(7 bytes)"`)
+	assert.Contains(t, out.String(), `"num":1234567890`)
+	assert.Contains(t, out.String(), `"g":{"nested":"abcde...(8 bytes)"}`)
+	assert.Contains(t, out.String(), `"msg":"mmmmmmmmmm"`)
+
+	t.Run("message untouched under GCP format", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		Configure(WithOutput(out), WithGCPFormat(), WithMaxValueLen(5))
+
+		Error(strings.Repeat("m", 10), slog.String("long", strings.Repeat("a", 10)))
+
+		assert.Contains(t, out.String(), `"message":"mmmmmmmmmm"`, "expected the renamed message not to be truncated")
+		assert.Contains(t, out.String(), `"long":"aaaaa...(10 bytes)"`)
+	})
+}
+
+func TestWithDefaultAttrs(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithDefaultAttrs(slog.String("service", "api"), slog.Int("version", 2)))
+
+	Error("first")
+	CopyLogger().Error("second")
+
+	assert.Equal(t, 2, strings.Count(out.String(), `"service":"api","version":2`))
+
+	out.Reset()
+	WithDefaultAttrs()()
+	Error("third")
+
+	assert.NotContains(t, out.String(), "service")
+}
+
+func TestWithNilValue(t *testing.T) {
+	defer resetLoggerConf()
+
+	var (
+		nilPtr *int
+		nilErr error
+	)
+
+	tests := []struct {
+		name     string
+		format   LoggingOptions
+		repr     string
+		expected []string
+		absent   []string
+	}{
+		{"json sentinel", WithJSONFormat(), "-", []string{`"a":"-"`, `"b":"-"`, `"c":"-"`, `"n":1`}, nil},
+		{"json empty", WithJSONFormat(), "", []string{`"a":""`, `"b":""`, `"c":""`}, []string{"null"}},
+		{"json drop", WithJSONFormat(), DropNil, []string{`"n":1`}, []string{`"a"`, `"b"`, `"c"`, "null"}},
+		{"text sentinel", WithTextFormat(), "-", []string{"a=- ", "b=- ", "c=- ", "n=1"}, []string{"<nil>"}},
+		{"text empty", WithTextFormat(), "", []string{`a="" `, `b="" `, `c="" `}, []string{"<nil>"}},
+		{"text drop", WithTextFormat(), DropNil, []string{"n=1"}, []string{"a=", "b=", "c=", "<nil>"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			Configure(WithOutput(out), tt.format, WithNilValue(tt.repr))
+
+			Error("nil", "a", nil, "b", nilPtr, "c", nilErr, "n", 1)
+
+			for _, s := range tt.expected {
+				assert.Contains(t, out.String(), s)
+			}
+			for _, s := range tt.absent {
+				assert.NotContains(t, out.String(), s)
+			}
+		})
+	}
+}
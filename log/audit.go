@@ -0,0 +1,62 @@
+package log
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// auditUnknown replaces missing mandatory audit fields.
+const auditUnknown = "unknown"
+
+// auditKey is the context key marking audit records, which lossy handlers such as sampling and deduplication pass through.
+type auditKey struct{}
+
+// isAudit reports whether ctx carries an audit record.
+func isAudit(ctx context.Context) bool {
+	return ctx.Value(auditKey{}) != nil
+}
+
+// Audit emits an audit record through the global logger. The record carries an "audit": true marker,
+// the mandatory "actor", "action", "resource" and "outcome" fields and the provided attrs.
+// Audit records are emitted at slog.LevelInfo regardless of the configured log level
+// and are never dropped by WithProbabilisticSampling or collapsed by WithDedup.
+//
+// Empty mandatory fields are set to "unknown" and a warning naming them is logged,
+// so a malformed audit record is never silently dropped.
+func Audit(actor, action, resource, outcome string, attrs ...slog.Attr) {
+	fields := [...]struct {
+		key   string
+		value *string
+	}{
+		{"actor", &actor},
+		{"action", &action},
+		{"resource", &resource},
+		{"outcome", &outcome},
+	}
+
+	var missing []string
+	for _, f := range fields {
+		if strings.TrimSpace(*f.value) == "" {
+			missing = append(missing, f.key)
+			*f.value = auditUnknown
+		}
+	}
+	if len(missing) > 0 {
+		Warn("audit record is missing mandatory fields", "missing", missing)
+	}
+
+	r := slog.NewRecord(time.Now(), slog.LevelInfo, "audit", 0)
+	r.AddAttrs(
+		slog.Bool("audit", true),
+		slog.String("actor", actor),
+		slog.String("action", action),
+		slog.String("resource", resource),
+		slog.String("outcome", outcome),
+	)
+	r.AddAttrs(attrs...)
+
+	// Handle is called directly to bypass the level check of the logger.
+	_ = globalLogger.Load().Handler().Handle(context.WithValue(context.Background(), auditKey{}, true), r)
+}
@@ -0,0 +1,82 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAudit(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithLogLevel("error"))
+
+	Audit("alice", "delete", "invoice/42", "success", slog.String("ip", "10.0.0.1"))
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(out.Bytes(), &record))
+
+	assert.Equal(t, "INFO", record["level"])
+	assert.Equal(t, true, record["audit"])
+	assert.Equal(t, "alice", record["actor"])
+	assert.Equal(t, "delete", record["action"])
+	assert.Equal(t, "invoice/42", record["resource"])
+	assert.Equal(t, "success", record["outcome"])
+	assert.Equal(t, "10.0.0.1", record["ip"])
+}
+
+func TestAudit_MissingFields(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out))
+
+	Audit("alice", "", " ", "failure")
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 2)
+
+	assert.Contains(t, lines[0], `"level":"WARN"`)
+	assert.Contains(t, lines[0], `"missing":["action","resource"]`)
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &record))
+
+	assert.Equal(t, true, record["audit"])
+	assert.Equal(t, "alice", record["actor"])
+	assert.Equal(t, "unknown", record["action"])
+	assert.Equal(t, "unknown", record["resource"])
+	assert.Equal(t, "failure", record["outcome"])
+}
+
+func TestAudit_SamplingAndDedup(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(
+		WithOutput(out),
+		WithLogLevel("info"),
+		WithProbabilisticSampling(slog.LevelInfo, 0),
+		WithDedup(time.Minute),
+	)
+
+	for range 5 {
+		Audit("alice", "delete", "invoice/42", "success")
+	}
+	Info("sampled away")
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 5, "expected every audit record to be emitted verbatim")
+	for _, line := range lines {
+		var record map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		assert.Equal(t, "audit", record["msg"])
+		assert.Equal(t, "alice", record["actor"])
+	}
+}
@@ -0,0 +1,69 @@
+package log
+
+import (
+	"bufio"
+	"io"
+	"sync"
+)
+
+// WithBufferedOutput wraps the configured output in a bufio.Writer of the given size,
+// reducing the number of write syscalls for file outputs.
+// Buffered records are written out when the buffer fills up or when Flush is called,
+// so Flush must be called before the application exits to avoid losing records.
+// Changing the output with WithOutput flushes the buffer and keeps buffering the new output.
+// A size of 0 or below flushes pending records and disables buffering.
+func WithBufferedOutput(size int) LoggingOptions {
+	return func() {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		if buffered != nil {
+			_ = buffered.Flush()
+			buffered = nil
+		}
+		if size > 0 {
+			buffered = newBufferedWriter(healingOutput, size)
+		}
+		storeLogger(output)
+	}
+}
+
+// Flush writes any buffered records to the underlying output.
+// It is a no-op unless WithBufferedOutput is enabled.
+func Flush() error {
+	mtx.Lock()
+	b := buffered
+	mtx.Unlock()
+
+	if b == nil {
+		return nil
+	}
+	return b.Flush()
+}
+
+// bufferedWriter is a bufio.Writer safe for concurrent use by several handlers.
+type bufferedWriter struct {
+	mu sync.Mutex
+	w  *bufio.Writer
+}
+
+func newBufferedWriter(out io.Writer, size int) *bufferedWriter {
+	return &bufferedWriter{w: bufio.NewWriterSize(out, size)}
+}
+
+func (b *bufferedWriter) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.w.Write(p)
+}
+
+func (b *bufferedWriter) Flush() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.w.Flush()
+}
+
+// size returns the size of the underlying buffer in bytes.
+func (b *bufferedWriter) size() int {
+	return b.w.Size()
+}
@@ -0,0 +1,102 @@
+package log
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWithBufferedOutput(t *testing.T) {
+	defer resetLoggerConf()
+
+	t.Run("Flush writes pending data", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		Configure(WithOutput(out), WithBufferedOutput(4096))
+
+		val := getRandomString()
+		Error(val)
+		assert.Empty(t, out.String())
+
+		require.NoError(t, Flush())
+		assert.Contains(t, out.String(), val)
+	})
+
+	t.Run("WithOutput flushes previous output", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		first := &bytes.Buffer{}
+		second := &bytes.Buffer{}
+		Configure(WithOutput(first), WithBufferedOutput(4096))
+
+		val1 := getRandomString()
+		Error(val1)
+		Configure(WithOutput(second))
+		assert.Contains(t, first.String(), val1)
+
+		val2 := getRandomString()
+		Error(val2)
+		assert.Empty(t, second.String())
+
+		require.NoError(t, Flush())
+		assert.Contains(t, second.String(), val2)
+	})
+
+	t.Run("disable", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		Configure(WithOutput(out), WithBufferedOutput(4096))
+
+		val := getRandomString()
+		Error(val)
+		Configure(WithBufferedOutput(0))
+		assert.Contains(t, out.String(), val)
+		assert.Nil(t, buffered)
+	})
+
+	t.Run("Flush without buffering", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		require.NoError(t, Flush())
+	})
+}
+
+func BenchmarkOutput(b *testing.B) {
+	defer resetLoggerConf()
+
+	b.Run("unbuffered", func(b *testing.B) {
+		defer resetLoggerConf()
+
+		f, err := os.Create(filepath.Join(b.TempDir(), "unbuffered.log"))
+		require.NoError(b, err)
+		defer func() { _ = f.Close() }()
+
+		Configure(WithOutput(f))
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			Error("benchmark", "i", i)
+		}
+	})
+
+	b.Run("buffered", func(b *testing.B) {
+		defer resetLoggerConf()
+
+		f, err := os.Create(filepath.Join(b.TempDir(), "buffered.log"))
+		require.NoError(b, err)
+		defer func() { _ = f.Close() }()
+
+		Configure(WithOutput(f), WithBufferedOutput(64*1024))
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			Error("benchmark", "i", i)
+		}
+		require.NoError(b, Flush())
+	})
+}
@@ -0,0 +1,88 @@
+package log
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"sync/atomic"
+)
+
+// channelDrops is the number of records WithChannel dropped because the channel was full.
+var channelDrops atomic.Uint64
+
+// WithChannel sends a copy of every record enabled by the log level to ch, in addition to writing it out,
+// so other subsystems (e.g. alerting) can react to records without parsing the output. The copy carries
+// the attributes added with WithDefaultAttrs and slog.Logger.With, and is taken before sampling and deduplication.
+// Sends never block: records are dropped when ch is full and counted by ChannelDrops. A nil ch disables sending.
+func WithChannel(ch chan<- slog.Record) LoggingOptions {
+	return func() {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		recordChannel = ch
+		storeLogger(output)
+	}
+}
+
+// ChannelDrops returns the number of records dropped by WithChannel because the channel was full,
+// since the process started.
+func ChannelDrops() uint64 {
+	return channelDrops.Load()
+}
+
+// channelFrame holds the attributes added to a channelHandler within a group; the first frame has no group.
+type channelFrame struct {
+	group string
+	attrs []slog.Attr
+}
+
+// channelHandler is a slog.Handler sending a copy of each record to a channel.
+type channelHandler struct {
+	next   slog.Handler
+	ch     chan<- slog.Record
+	frames []channelFrame
+}
+
+func (h *channelHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *channelHandler) Handle(ctx context.Context, r slog.Record) error {
+	select {
+	case h.ch <- h.copy(r):
+	default:
+		channelDrops.Add(1)
+	}
+	return h.next.Handle(ctx, r)
+}
+
+func (h *channelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	frames := slices.Clone(h.frames)
+	last := &frames[len(frames)-1]
+	last.attrs = append(slices.Clip(last.attrs), attrs...)
+	return &channelHandler{next: h.next.WithAttrs(attrs), ch: h.ch, frames: frames}
+}
+
+func (h *channelHandler) WithGroup(name string) slog.Handler {
+	frames := append(slices.Clip(h.frames), channelFrame{group: name})
+	return &channelHandler{next: h.next.WithGroup(name), ch: h.ch, frames: frames}
+}
+
+// copy returns a copy of r carrying the attributes and groups added to the handler.
+func (h *channelHandler) copy(r slog.Record) slog.Record {
+	attrs := make([]slog.Attr, 0, r.NumAttrs())
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, a)
+		return true
+	})
+
+	for i := len(h.frames) - 1; i > 0; i-- {
+		frame := h.frames[i]
+		attrs = []slog.Attr{{Key: frame.group, Value: slog.GroupValue(append(slices.Clip(frame.attrs), attrs...)...)}}
+	}
+	attrs = append(slices.Clip(h.frames[0].attrs), attrs...)
+
+	c := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+	c.AddAttrs(attrs...)
+	return c
+}
@@ -0,0 +1,72 @@
+package log
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWithChannel(t *testing.T) {
+	defer resetLoggerConf()
+
+	ch := make(chan slog.Record, 10)
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithChannel(ch), WithDefaultAttrs(slog.String("service", "api")))
+
+	Info("filtered")
+	Error("disk full", "disk", "sda")
+	CopyLogger().With("worker", 1).WithGroup("job").Warn("retrying", "attempt", 2)
+
+	require.Len(t, ch, 2, "expected enabled records to be sent to the channel")
+
+	r := <-ch
+	assert.Equal(t, slog.LevelError, r.Level)
+	assert.Equal(t, "disk full", r.Message)
+	assert.Equal(t, map[string]string{"service": "api", "disk": "sda"}, recordAttrs(r))
+
+	r = <-ch
+	assert.Equal(t, "retrying", r.Message)
+	assert.Equal(t, map[string]string{"service": "api", "worker": "1", "job": "[attempt=2]"}, recordAttrs(r))
+
+	assert.Contains(t, out.String(), `"msg":"disk full"`, "expected records to be written out as well")
+}
+
+func TestWithChannel_Full(t *testing.T) {
+	defer resetLoggerConf()
+
+	ch := make(chan slog.Record, 1)
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithChannel(ch))
+
+	drops := ChannelDrops()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range 3 {
+			Error("event")
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected logging not to block on a full channel")
+	}
+
+	assert.Len(t, ch, 1)
+	assert.Equal(t, drops+2, ChannelDrops(), "expected dropped records to be counted")
+	assert.Equal(t, 3, strings.Count(out.String(), `"msg":"event"`), "expected every record to be written out")
+}
+
+func recordAttrs(r slog.Record) map[string]string {
+	attrs := make(map[string]string)
+	r.Attrs(func(a slog.Attr) bool {
+		attrs[a.Key] = a.Value.String()
+		return true
+	})
+	return attrs
+}
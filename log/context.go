@@ -0,0 +1,48 @@
+package log
+
+import (
+	"context"
+	"log/slog"
+)
+
+// WithContextExtractor sets a function extracting attributes from the context passed to the
+// context-aware emitters (DebugContext, InfoContext, WarnContext, ErrorContext).
+// The returned attributes are appended to every record, e.g. to add trace IDs stored in a request context.
+// Passing a nil fn disables extraction.
+func WithContextExtractor(fn func(ctx context.Context) []slog.Attr) LoggingOptions {
+	return func() {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		contextExtractor = fn
+		storeLogger(output)
+	}
+}
+
+// contextHandler is a slog.Handler appending the attributes extracted from the record context.
+type contextHandler struct {
+	next    slog.Handler
+	extract func(ctx context.Context) []slog.Attr
+}
+
+func (h *contextHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if ctx != nil {
+		if attrs := h.extract(ctx); len(attrs) > 0 {
+			r = r.Clone()
+			r.AddAttrs(attrs...)
+		}
+	}
+	return h.next.Handle(ctx, r)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{next: h.next.WithAttrs(attrs), extract: h.extract}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{next: h.next.WithGroup(name), extract: h.extract}
+}
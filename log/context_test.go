@@ -0,0 +1,60 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"log/slog"
+	"testing"
+)
+
+type traceIDKey struct{}
+
+func TestWithContextExtractor(t *testing.T) {
+	defer resetLoggerConf()
+
+	extractor := func(ctx context.Context) []slog.Attr {
+		if id, ok := ctx.Value(traceIDKey{}).(string); ok {
+			return []slog.Attr{slog.String("trace_id", id)}
+		}
+		return nil
+	}
+
+	t.Run("attribute extracted", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		Configure(WithOutput(out), WithLogLevel("debug"), WithContextExtractor(extractor))
+
+		ctx := context.WithValue(context.Background(), traceIDKey{}, "abc-123")
+		DebugContext(ctx, "debug")
+		InfoContext(ctx, "info")
+		WarnContext(ctx, "warn")
+		ErrorContext(ctx, "error")
+
+		assert.Equal(t, 4, bytes.Count(out.Bytes(), []byte(`"trace_id":"abc-123"`)))
+	})
+
+	t.Run("no value in context", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		Configure(WithOutput(out), WithContextExtractor(extractor))
+
+		ErrorContext(context.Background(), "error")
+		Error("no context")
+
+		assert.NotContains(t, out.String(), "trace_id")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		Configure(WithOutput(out), WithContextExtractor(extractor), WithContextExtractor(nil))
+
+		ErrorContext(context.WithValue(context.Background(), traceIDKey{}, "abc-123"), "error")
+
+		assert.NotContains(t, out.String(), "trace_id")
+	})
+}
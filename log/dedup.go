@@ -0,0 +1,160 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+// WithDedup collapses consecutive identical records, like syslog does. A record with the same level, message
+// and attributes as the previous one is suppressed; when a different record arrives or window elapses since
+// the first suppressed duplicate, a "last message repeated N times" record is emitted at the same level.
+// A non-positive window disables deduplication, emitting the summary of pending duplicates first.
+//
+// The streak survives reconfiguration of the logger, so other options don't drop pending summaries.
+func WithDedup(window time.Duration) LoggingOptions {
+	return func() {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		if window <= 0 {
+			recordStreak.end()
+		}
+		dedupWindow = window
+		storeLogger(output)
+	}
+}
+
+// recordStreak is the process-wide streak of identical records, kept across logger rebuilds.
+var recordStreak = &dedupState{}
+
+// dedupState is the streak shared by dedupHandlers.
+type dedupState struct {
+	mtx      sync.Mutex
+	last     string
+	next     slog.Handler // handler the last record was passed to
+	level    slog.Level
+	repeated int
+	window   *dedupTimer // window of the current streak, nil until a duplicate arrives
+}
+
+// dedupTimer ends the window it was started for; a timer of an earlier window is ignored.
+type dedupTimer struct {
+	timer *time.Timer
+}
+
+// dedupHandler is a slog.Handler suppressing records identical to the previous one.
+type dedupHandler struct {
+	next   slog.Handler
+	state  *dedupState
+	window time.Duration
+	scope  string // attrs and groups added with WithAttrs and WithGroup, part of the record identity
+}
+
+func newDedupHandler(next slog.Handler, window time.Duration, state *dedupState) *dedupHandler {
+	return &dedupHandler{next: next, state: state, window: window}
+}
+
+func (h *dedupHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *dedupHandler) Handle(ctx context.Context, r slog.Record) error {
+	if isAudit(ctx) {
+		return h.next.Handle(ctx, r)
+	}
+
+	key := h.key(r)
+
+	s := h.state
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if key == s.last {
+		s.repeated++
+		// The summary goes to the latest handler, e.g. after the logger was rebuilt.
+		s.next = h.next
+		if s.window == nil {
+			w := &dedupTimer{}
+			w.timer = time.AfterFunc(h.window, func() { s.expire(w) })
+			s.window = w
+		}
+		return nil
+	}
+
+	s.flush()
+	s.last = key
+	s.next = h.next
+	s.level = r.Level
+	return h.next.Handle(ctx, r)
+}
+
+func (h *dedupHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	var b strings.Builder
+	b.WriteString(h.scope)
+	for _, a := range attrs {
+		b.WriteString(a.String())
+		b.WriteByte(' ')
+	}
+	return &dedupHandler{next: h.next.WithAttrs(attrs), state: h.state, window: h.window, scope: b.String()}
+}
+
+func (h *dedupHandler) WithGroup(name string) slog.Handler {
+	return &dedupHandler{next: h.next.WithGroup(name), state: h.state, window: h.window, scope: h.scope + name + ". "}
+}
+
+// key returns the identity of the record: its level, message and attributes.
+func (h *dedupHandler) key(r slog.Record) string {
+	var b strings.Builder
+	b.WriteString(h.scope)
+	b.WriteString(r.Level.String())
+	b.WriteByte(' ')
+	b.WriteString(r.Message)
+	r.Attrs(func(a slog.Attr) bool {
+		b.WriteByte(' ')
+		b.WriteString(a.String())
+		return true
+	})
+	return b.String()
+}
+
+// expire ends the streak once window elapses, so the next identical record is emitted again.
+// It is a no-op if window already ended, e.g. because a different record arrived meanwhile.
+func (s *dedupState) expire(window *dedupTimer) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if window != s.window {
+		return
+	}
+	s.flush()
+	s.last = ""
+}
+
+// end emits the summary of the pending duplicates and forgets the streak.
+func (s *dedupState) end() {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.flush()
+	s.last, s.next = "", nil
+}
+
+// flush emits the summary of the suppressed duplicates, if any, and ends the window.
+// It must be called with s.mtx held.
+func (s *dedupState) flush() {
+	if s.window != nil {
+		s.window.timer.Stop()
+		s.window = nil
+	}
+	if s.repeated == 0 {
+		return
+	}
+
+	r := slog.NewRecord(time.Now(), s.level, fmt.Sprintf("last message repeated %d times", s.repeated), 0)
+	s.repeated = 0
+	_ = s.next.Handle(context.Background(), r)
+}
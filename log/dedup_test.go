@@ -0,0 +1,126 @@
+package log
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWithDedup(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithDedup(time.Minute))
+
+	for range 3 {
+		Error("disk full", "disk", "sda")
+	}
+	Error("disk ok", "disk", "sda")
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 3, "expected the duplicates to be collapsed")
+	assert.Contains(t, lines[0], `"msg":"disk full"`)
+	assert.Contains(t, lines[1], `"level":"ERROR","msg":"last message repeated 2 times"`)
+	assert.Contains(t, lines[2], `"msg":"disk ok"`)
+}
+
+func TestWithDedup_DifferentAttrs(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithDedup(time.Minute))
+
+	Error("disk full", "disk", "sda")
+	Error("disk full", "disk", "sdb")
+	Warn("disk full", "disk", "sdb")
+
+	assert.Equal(t, 3, strings.Count(out.String(), "\n"), "expected records with different attrs or levels to be emitted")
+	assert.NotContains(t, out.String(), "repeated")
+}
+
+func TestWithDedup_Window(t *testing.T) {
+	sink := NewMemorySink()
+	logger := slog.New(newDedupHandler(sink, 20*time.Millisecond, &dedupState{}))
+
+	for range 3 {
+		logger.Error("disk full")
+	}
+	require.Len(t, sink.Records(), 1, "expected the duplicates to be suppressed")
+
+	require.Eventually(t, func() bool {
+		return len(sink.Records()) == 2
+	}, time.Second, 5*time.Millisecond, "expected a summary once the window elapses")
+
+	summary := sink.Records()[1]
+	assert.Equal(t, "last message repeated 2 times", summary.Message)
+	assert.Equal(t, slog.LevelError, summary.Level)
+
+	logger.Error("disk full")
+	assert.Len(t, sink.Records(), 3, "expected the streak to restart after the window")
+}
+
+func TestWithDedup_Disabled(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithDedup(time.Minute), WithDedup(0))
+
+	for range 3 {
+		Error("disk full")
+	}
+
+	assert.Equal(t, 3, strings.Count(out.String(), "\n"), "expected no deduplication when disabled")
+}
+
+func TestWithDedup_Reconfigure(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithDedup(time.Minute))
+
+	Error("disk full")
+	Error("disk full")
+	Configure(WithLogLevel("debug"))
+	Error("disk full")
+	Error("disk ok")
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 3, "expected the streak to survive the reconfiguration")
+	assert.Contains(t, lines[1], `"msg":"last message repeated 2 times"`)
+	assert.Contains(t, lines[2], `"msg":"disk ok"`)
+}
+
+func TestWithDedup_DisableFlushes(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithDedup(time.Minute))
+
+	Error("disk full")
+	Error("disk full")
+	Configure(WithDedup(0))
+
+	assert.Contains(t, out.String(), `"msg":"last message repeated 1 times"`, "expected pending duplicates to be reported when disabled")
+}
+
+func TestWithDedup_StaleTimer(t *testing.T) {
+	sink := NewMemorySink()
+	state := &dedupState{}
+	logger := slog.New(newDedupHandler(sink, time.Minute, state))
+
+	logger.Error("disk full")
+	logger.Error("disk full")
+	stale := state.window
+
+	logger.Error("disk ok")
+	logger.Error("disk ok")
+	state.expire(stale)
+
+	require.Len(t, sink.Records(), 3, "expected a stale timer not to end the newer window")
+	logger.Error("disk ok")
+	assert.Len(t, sink.Records(), 3, "expected the newer window to keep suppressing duplicates")
+}
@@ -0,0 +1,84 @@
+package log
+
+import (
+	"context"
+	"log/slog"
+)
+
+// WithMaxAttrDepth limits how deeply attribute groups may be nested.
+// Groups nested beyond n levels are dropped and replaced with a single "...":"truncated" attribute,
+// which bounds the serialization cost of pathological inputs such as recursive slog.LogValuer values.
+// A value of 0 or below disables the limit, which is the default.
+func WithMaxAttrDepth(n int) LoggingOptions {
+	return func() {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		if n < 0 {
+			n = 0
+		}
+		maxAttrDepth = n
+		storeLogger(output)
+	}
+}
+
+// depthHandler is a slog.Handler truncating attribute groups nested deeper than limit.
+type depthHandler struct {
+	next  slog.Handler
+	limit int
+	depth int // number of groups opened via WithGroup
+}
+
+func (h *depthHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *depthHandler) Handle(ctx context.Context, r slog.Record) error {
+	attrs := make([]slog.Attr, 0, r.NumAttrs())
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, a)
+		return true
+	})
+
+	nr := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+	nr.AddAttrs(truncateAttrs(attrs, h.depth, h.limit)...)
+
+	return h.next.Handle(ctx, nr)
+}
+
+func (h *depthHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &depthHandler{next: h.next.WithAttrs(truncateAttrs(attrs, h.depth, h.limit)), limit: h.limit, depth: h.depth}
+}
+
+func (h *depthHandler) WithGroup(name string) slog.Handler {
+	return &depthHandler{next: h.next.WithGroup(name), limit: h.limit, depth: h.depth + 1}
+}
+
+// truncateAttrs returns attrs with every group nested deeper than limit replaced by a "...":"truncated" marker.
+// depth is the nesting level of attrs. LogValuer values are resolved so that their groups are bounded as well.
+func truncateAttrs(attrs []slog.Attr, depth, limit int) []slog.Attr {
+	res := make([]slog.Attr, 0, len(attrs))
+	truncated := false
+
+	for _, a := range attrs {
+		a.Value = a.Value.Resolve()
+		if a.Value.Kind() != slog.KindGroup {
+			res = append(res, a)
+			continue
+		}
+
+		if depth >= limit {
+			truncated = true
+			continue
+		}
+
+		a.Value = slog.GroupValue(truncateAttrs(a.Value.Group(), depth+1, limit)...)
+		res = append(res, a)
+	}
+
+	if truncated {
+		res = append(res, slog.String("...", "truncated"))
+	}
+
+	return res
+}
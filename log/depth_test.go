@@ -0,0 +1,67 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"testing"
+)
+
+func TestWithMaxAttrDepth(t *testing.T) {
+	defer resetLoggerConf()
+
+	t.Run("truncated at limit", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		Configure(WithOutput(out), WithMaxAttrDepth(2))
+
+		Error("nested",
+			slog.Group("a",
+				slog.Int("x", 1),
+				slog.Group("b",
+					slog.Int("y", 2),
+					slog.Group("c",
+						slog.Group("d", slog.Int("z", 3)),
+					),
+				),
+			),
+		)
+
+		var record map[string]any
+		require.NoError(t, json.Unmarshal(out.Bytes(), &record))
+
+		expected := map[string]any{
+			"x": float64(1),
+			"b": map[string]any{
+				"y":   float64(2),
+				"...": "truncated",
+			},
+		}
+		assert.Equal(t, expected, record["a"])
+	})
+
+	t.Run("WithGroup counts towards depth", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		Configure(WithOutput(out), WithMaxAttrDepth(1))
+
+		globalLogger.Load().WithGroup("req").Error("nested", slog.Int("x", 1), slog.Group("b", slog.Int("y", 2)))
+
+		assert.Contains(t, out.String(), `"req":{"x":1,"...":"truncated"}`)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		Configure(WithOutput(out), WithMaxAttrDepth(0))
+
+		Error("nested", slog.Group("a", slog.Group("b", slog.Group("c", slog.Int("z", 3)))))
+
+		assert.Contains(t, out.String(), `"a":{"b":{"c":{"z":3}}}`)
+	})
+}
@@ -0,0 +1,55 @@
+package log
+
+import "log/slog"
+
+// Google Cloud Logging field names, see https://cloud.google.com/logging/docs/structured-logging.
+const (
+	gcpSeverityKey = "severity"
+	gcpMessageKey  = "message"
+)
+
+// WithGCPFormat makes records parseable by Google Cloud Logging: the level is emitted as "severity"
+// with a Cloud Logging severity ("DEBUG", "INFO", "WARNING", "ERROR" or "CRITICAL") and the message
+// as "message". Intended for use with the JSON format. It takes precedence over WithLowercaseLevels
+// and the TRACE level name, which is reported as "DEBUG".
+func WithGCPFormat() LoggingOptions {
+	return func() {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		gcpFormat = true
+		storeLogger(output)
+	}
+}
+
+// gcpAttr is a ReplaceAttr function renaming the level and message attributes to their Cloud Logging names.
+func gcpAttr(groups []string, a slog.Attr) slog.Attr {
+	if len(groups) > 0 {
+		return a
+	}
+
+	switch a.Key {
+	case slog.LevelKey:
+		level, _ := a.Value.Any().(slog.Level)
+		return slog.String(gcpSeverityKey, gcpSeverity(level))
+	case slog.MessageKey:
+		a.Key = gcpMessageKey
+	}
+	return a
+}
+
+// gcpSeverity maps a slog level to a Cloud Logging severity.
+func gcpSeverity(level slog.Level) string {
+	switch {
+	case level < slog.LevelInfo:
+		return "DEBUG"
+	case level < slog.LevelWarn:
+		return "INFO"
+	case level < slog.LevelError:
+		return "WARNING"
+	case level < slog.LevelError+4:
+		return "ERROR"
+	default:
+		return "CRITICAL"
+	}
+}
@@ -0,0 +1,60 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func TestWithGCPFormat(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithGCPFormat())
+
+	Warn("disk almost full", slog.Group("disk", slog.String("level", "90%")))
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(out.Bytes(), &record))
+
+	assert.Equal(t, "WARNING", record["severity"])
+	assert.Equal(t, "disk almost full", record["message"])
+	assert.NotContains(t, record, "level")
+	assert.NotContains(t, record, "msg")
+	assert.Equal(t, map[string]any{"level": "90%"}, record["disk"], "expected nested attributes to be untouched")
+}
+
+func TestWithGCPFormat_Severities(t *testing.T) {
+	defer resetLoggerConf()
+
+	tests := []struct {
+		level    slog.Level
+		expected string
+	}{
+		{LevelTrace, "DEBUG"},
+		{slog.LevelDebug, "DEBUG"},
+		{slog.LevelInfo, "INFO"},
+		{slog.LevelInfo + 2, "INFO"},
+		{slog.LevelWarn, "WARNING"},
+		{slog.LevelError, "ERROR"},
+		{slog.LevelError + 4, "CRITICAL"},
+	}
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithLogLevel("trace"), WithLowercaseLevels(), WithGCPFormat())
+
+	for _, tt := range tests {
+		t.Run(tt.level.String(), func(t *testing.T) {
+			out.Reset()
+			LogAt(time.Now(), tt.level, "msg")
+
+			var record map[string]any
+			require.NoError(t, json.Unmarshal(out.Bytes(), &record))
+			assert.Equal(t, tt.expected, record["severity"])
+		})
+	}
+}
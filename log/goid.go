@@ -0,0 +1,68 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"runtime"
+	"strconv"
+)
+
+// WithGoroutineID attaches a "goid" attribute holding the ID of the emitting goroutine to every record.
+// The ID is parsed from the runtime stack trace on each record, which is relatively expensive:
+// the option is intended for diagnosing concurrency bugs in development, not for production use.
+func WithGoroutineID() LoggingOptions {
+	return func() {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		goroutineID = true
+		storeLogger(output)
+	}
+}
+
+// goidHandler is a slog.Handler appending the ID of the emitting goroutine.
+type goidHandler struct {
+	next slog.Handler
+}
+
+func (h *goidHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *goidHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := currentGoroutineID(); id > 0 {
+		r = r.Clone()
+		r.AddAttrs(slog.Uint64("goid", id))
+	}
+	return h.next.Handle(ctx, r)
+}
+
+func (h *goidHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &goidHandler{next: h.next.WithAttrs(attrs)}
+}
+
+func (h *goidHandler) WithGroup(name string) slog.Handler {
+	return &goidHandler{next: h.next.WithGroup(name)}
+}
+
+// currentGoroutineID parses the ID of the calling goroutine from the first line of its stack trace,
+// "goroutine <id> [<state>]:". It returns 0 if the line can't be parsed.
+func currentGoroutineID() uint64 {
+	var buf [64]byte
+	line := buf[:runtime.Stack(buf[:], false)]
+
+	line, ok := bytes.CutPrefix(line, []byte("goroutine "))
+	if !ok {
+		return 0
+	}
+	if i := bytes.IndexByte(line, ' '); i >= 0 {
+		line = line[:i]
+	}
+
+	id, err := strconv.ParseUint(string(line), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return id
+}
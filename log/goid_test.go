@@ -0,0 +1,42 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestWithGoroutineID(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out))
+
+	goid := func() float64 {
+		out.Reset()
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			Error("goroutine")
+		}()
+		<-done
+
+		var record map[string]any
+		require.NoError(t, json.Unmarshal(out.Bytes(), &record))
+
+		id, _ := record["goid"].(float64)
+		return id
+	}
+
+	assert.Zero(t, goid(), "expected no goid unless enabled")
+
+	WithGoroutineID()()
+
+	first, second := goid(), goid()
+	assert.Positive(t, first)
+	assert.Positive(t, second)
+	assert.NotEqual(t, first, second, "expected different goroutines to report different IDs")
+}
@@ -0,0 +1,43 @@
+package log
+
+import (
+	"context"
+	"log/slog"
+)
+
+// WithHandler makes the global logger pass records to h instead of formatting them to the output,
+// e.g. a MemorySink capturing the records emitted through the package functions in tests.
+// The configured log level and the handler wrappers, such as default attrs, record hooks or sampling, still apply;
+// the format, the output and ReplaceAttr-based options such as WithMaxValueLen don't.
+// Passing nil restores the built-in format handler.
+func WithHandler(h slog.Handler) LoggingOptions {
+	return func() {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		customHandler = h
+		storeLogger(output)
+	}
+}
+
+// levelHandler is a slog.Handler dropping records below the configured level before they reach a custom handler.
+type levelHandler struct {
+	next  slog.Handler
+	level slog.Leveler
+}
+
+func (h *levelHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.level.Level() && h.next.Enabled(ctx, level)
+}
+
+func (h *levelHandler) Handle(ctx context.Context, r slog.Record) error {
+	return h.next.Handle(ctx, r)
+}
+
+func (h *levelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &levelHandler{next: h.next.WithAttrs(attrs), level: h.level}
+}
+
+func (h *levelHandler) WithGroup(name string) slog.Handler {
+	return &levelHandler{next: h.next.WithGroup(name), level: h.level}
+}
@@ -0,0 +1,79 @@
+package log
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"sync"
+)
+
+// maxWriteFailures is the number of consecutive failed writes after which a write error is considered persistent.
+const maxWriteFailures = 3
+
+// writeFallback is the writer records are redirected to once the output fails persistently.
+var writeFallback io.Writer = os.Stderr
+
+// healingWriter is an io.Writer redirecting writes to writeFallback once the underlying file fails
+// persistently, e.g. because its descriptor was closed by a faulty log rotation. The failure is reported
+// once with a structured alert written to the fallback. Records whose write fails are written to the fallback
+// instead, including those failing before the error is considered persistent, so none of them is lost.
+type healingWriter struct {
+	mu       sync.Mutex
+	out      io.Writer
+	fallback io.Writer
+	failures int
+	healed   bool
+}
+
+// selfHealing wraps file outputs, such as log files, in a healingWriter.
+// The standard streams and other writers are returned as is. The output set by WithOutput is wrapped once
+// and cached in healingOutput, beneath the buffer of WithBufferedOutput if any.
+func selfHealing(out io.Writer) io.Writer {
+	if f, ok := out.(*os.File); ok && f != nil && f != os.Stdout && f != os.Stderr {
+		return &healingWriter{out: out, fallback: writeFallback}
+	}
+	return out
+}
+
+func (w *healingWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.healed {
+		return w.fallback.Write(p)
+	}
+
+	n, err := w.out.Write(p)
+	if err == nil {
+		w.failures = 0
+		return n, nil
+	}
+
+	w.failures++
+	if !errors.Is(err, os.ErrClosed) && w.failures < maxWriteFailures {
+		return w.fallback.Write(p)
+	}
+
+	w.healed = true
+	slog.New(slog.NewJSONHandler(w.fallback, nil)).Error("log output failed, falling back to "+writerName(w.fallback),
+		"error", err,
+		"failures", w.failures,
+	)
+	return w.fallback.Write(p)
+}
+
+// writerName describes w in the failure alert, e.g. "stderr" or the path of a file.
+func writerName(w io.Writer) string {
+	switch w {
+	case os.Stdout:
+		return "stdout"
+	case os.Stderr:
+		return "stderr"
+	}
+	if f, ok := w.(*os.File); ok {
+		return f.Name()
+	}
+	return fmt.Sprintf("%T", w)
+}
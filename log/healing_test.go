@@ -0,0 +1,125 @@
+package log
+
+import (
+	"bytes"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSelfHealingOutput(t *testing.T) {
+	defer resetLoggerConf()
+	defer func() { writeFallback = os.Stderr }()
+
+	fallback := &bytes.Buffer{}
+	writeFallback = fallback
+
+	path := filepath.Join(t.TempDir(), "app.log")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+
+	Configure(WithOutput(f))
+
+	Error("before close")
+	require.NoError(t, f.Close())
+	Error("after close")
+	Configure(WithLogLevel("info"), WithDefaultAttrs())
+	Error("later")
+
+	written, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(written), `"msg":"before close"`)
+	assert.NotContains(t, string(written), "after close")
+
+	lines := strings.Split(strings.TrimSpace(fallback.String()), "\n")
+	require.Len(t, lines, 3, "expected a single alert followed by the records, even after the logger is rebuilt")
+	assert.Contains(t, lines[0], `"msg":"log output failed, falling back to *bytes.Buffer"`)
+	assert.Contains(t, lines[0], "file already closed")
+	assert.Contains(t, lines[1], `"msg":"after close"`, "expected the failed record not to be lost")
+	assert.Contains(t, lines[2], `"msg":"later"`)
+}
+
+// failingWriter fails the writes while fail is set.
+type failingWriter struct {
+	fail bool
+	buf  bytes.Buffer
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if w.fail {
+		return 0, errors.New("disk full")
+	}
+	return w.buf.Write(p)
+}
+
+func TestHealingWriter_PersistentErrors(t *testing.T) {
+	out := &failingWriter{fail: true}
+	fallback := &bytes.Buffer{}
+	w := &healingWriter{out: out, fallback: fallback}
+
+	_, err := w.Write([]byte("one\n"))
+	assert.NoError(t, err, "expected a transiently failed record to be written to the fallback")
+	assert.Equal(t, "one\n", fallback.String(), "expected the failed record not to be lost")
+	fallback.Reset()
+
+	out.fail = false
+	_, err = w.Write([]byte("two\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, "two\n", out.buf.String(), "expected a recovered output to be kept")
+
+	out.fail = true
+	for range maxWriteFailures - 1 {
+		_, err = w.Write([]byte("failed\n"))
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, strings.Repeat("failed\n", maxWriteFailures-1), fallback.String(), "expected no alert before the error is persistent")
+
+	_, err = w.Write([]byte("three\n"))
+	assert.NoError(t, err)
+	assert.Contains(t, fallback.String(), `"error":"disk full"`)
+	assert.True(t, strings.HasSuffix(fallback.String(), "three\n"))
+
+	out.fail = false
+	_, _ = w.Write([]byte("four\n"))
+	assert.NotContains(t, out.buf.String(), "four", "expected writes to stay on the fallback")
+	assert.Equal(t, 1, strings.Count(fallback.String(), "log output failed"), "expected a single alert")
+}
+
+func TestSelfHealingOutput_Buffered(t *testing.T) {
+	defer resetLoggerConf()
+	defer func() { writeFallback = os.Stderr }()
+
+	fallback := &bytes.Buffer{}
+	writeFallback = fallback
+
+	path := filepath.Join(t.TempDir(), "app.log")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+
+	Configure(WithOutput(f), WithBufferedOutput(4096))
+
+	Error("before close")
+	require.NoError(t, Flush())
+	require.NoError(t, f.Close())
+	Error("after close")
+	require.NoError(t, Flush(), "expected the buffer to be flushed to the fallback")
+
+	written, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(written), `"msg":"before close"`)
+
+	lines := strings.Split(strings.TrimSpace(fallback.String()), "\n")
+	require.Len(t, lines, 2, "expected the alert followed by the record")
+	assert.Contains(t, lines[0], `"msg":"log output failed, falling back to *bytes.Buffer"`)
+	assert.Contains(t, lines[1], `"msg":"after close"`, "expected the buffered record not to be lost")
+}
+
+func TestWriterName(t *testing.T) {
+	assert.Equal(t, "stderr", writerName(os.Stderr))
+	assert.Equal(t, "stdout", writerName(os.Stdout))
+	assert.Equal(t, "*bytes.Buffer", writerName(&bytes.Buffer{}))
+}
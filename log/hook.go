@@ -0,0 +1,51 @@
+package log
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+)
+
+// WithRecordHook adds a hook invoked for every record just before it is handed to the underlying handler.
+// Unlike ReplaceAttr functions, which operate on single attributes, hooks may modify the whole record,
+// e.g. add computed attributes or change the message. Multiple hooks run in the order they were added.
+// Passing a nil fn removes all hooks.
+func WithRecordHook(fn func(ctx context.Context, r *slog.Record)) LoggingOptions {
+	return func() {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		if fn == nil {
+			recordHooks = nil
+		} else {
+			recordHooks = append(slices.Clip(recordHooks), fn)
+		}
+		storeLogger(output)
+	}
+}
+
+// hookHandler is a slog.Handler running record hooks before passing records on.
+type hookHandler struct {
+	next  slog.Handler
+	hooks []func(ctx context.Context, r *slog.Record)
+}
+
+func (h *hookHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *hookHandler) Handle(ctx context.Context, r slog.Record) error {
+	r = r.Clone()
+	for _, hook := range h.hooks {
+		hook(ctx, &r)
+	}
+	return h.next.Handle(ctx, r)
+}
+
+func (h *hookHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &hookHandler{next: h.next.WithAttrs(attrs), hooks: h.hooks}
+}
+
+func (h *hookHandler) WithGroup(name string) slog.Handler {
+	return &hookHandler{next: h.next.WithGroup(name), hooks: h.hooks}
+}
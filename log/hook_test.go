@@ -0,0 +1,68 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"hash/fnv"
+	"log/slog"
+	"testing"
+)
+
+func TestWithRecordHook(t *testing.T) {
+	defer resetLoggerConf()
+
+	t.Run("hook-added attribute", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		Configure(WithOutput(out), WithRecordHook(func(ctx context.Context, r *slog.Record) {
+			h := fnv.New64a()
+			_, _ = h.Write([]byte(r.Message))
+			r.AddAttrs(slog.Uint64("msg_hash", h.Sum64()))
+		}))
+
+		Error("dedup me")
+
+		h := fnv.New64a()
+		_, _ = h.Write([]byte("dedup me"))
+		assert.Contains(t, out.String(), `"msg_hash":`)
+		assert.Contains(t, out.String(), slog.Uint64Value(h.Sum64()).String())
+	})
+
+	t.Run("hooks run in order", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		Configure(
+			WithOutput(out),
+			WithRecordHook(func(ctx context.Context, r *slog.Record) {
+				r.Message += " first"
+			}),
+			WithRecordHook(func(ctx context.Context, r *slog.Record) {
+				r.Message += " second"
+			}),
+		)
+
+		Error("msg")
+
+		assert.Contains(t, out.String(), `"msg":"msg first second"`)
+	})
+
+	t.Run("nil removes hooks", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		Configure(
+			WithOutput(out),
+			WithRecordHook(func(ctx context.Context, r *slog.Record) {
+				r.AddAttrs(slog.Bool("hooked", true))
+			}),
+			WithRecordHook(nil),
+		)
+
+		Error("msg")
+
+		assert.NotContains(t, out.String(), "hooked")
+	})
+}
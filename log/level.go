@@ -0,0 +1,53 @@
+package log
+
+import (
+	"log/slog"
+	"strings"
+)
+
+// LevelTrace is the level of Trace records, below slog.LevelDebug.
+const LevelTrace = slog.LevelDebug - 4
+
+// traceLevelName is a ReplaceAttr function rendering LevelTrace as "TRACE" instead of "DEBUG-4".
+func traceLevelName(groups []string, a slog.Attr) slog.Attr {
+	if len(groups) == 0 && a.Key == slog.LevelKey {
+		if level, ok := a.Value.Any().(slog.Level); ok && level == LevelTrace {
+			a.Value = slog.StringValue("TRACE")
+		}
+	}
+	return a
+}
+
+// WithLowercaseLevels renders the level of each record in lowercase, e.g. "error" instead of "ERROR".
+// Only the level attribute is affected.
+func WithLowercaseLevels() LoggingOptions {
+	return func() {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		lowercaseLevels = true
+		storeLogger(output)
+	}
+}
+
+// lowercaseLevel is a ReplaceAttr function lowercasing the value of the top-level level attribute.
+func lowercaseLevel(groups []string, a slog.Attr) slog.Attr {
+	if len(groups) == 0 && a.Key == slog.LevelKey {
+		a.Value = slog.StringValue(strings.ToLower(a.Value.String()))
+	}
+	return a
+}
+
+// WithTemporaryLevel sets the log level to level, runs fn and restores the previous level afterward,
+// even if fn panics.
+//
+// The level is process-global, not goroutine-local: records emitted by other goroutines while fn runs
+// are filtered by the temporary level too, and concurrent calls may restore each other's levels.
+func WithTemporaryLevel(level slog.Level, fn func()) {
+	lv := globalLevel.Load()
+	previous := lv.Level()
+	lv.Set(level)
+	defer lv.Set(previous)
+
+	fn()
+}
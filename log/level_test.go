@@ -0,0 +1,112 @@
+package log
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"testing"
+)
+
+func TestWithLowercaseLevels(t *testing.T) {
+	defer resetLoggerConf()
+
+	t.Run("JSON", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		Configure(WithOutput(out), WithLowercaseLevels())
+
+		Error("lowercase", "kind", "ERROR", slog.Group("g", slog.String("level", "WARN")))
+
+		assert.Contains(t, out.String(), `"level":"error"`)
+		assert.Contains(t, out.String(), `"kind":"ERROR"`)
+		assert.Contains(t, out.String(), `"g":{"level":"WARN"}`)
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		Configure(WithLowercaseLevels(), WithTextFormat(), WithOutput(out))
+
+		Warn("lowercase")
+
+		assert.Contains(t, out.String(), "level=warn")
+	})
+}
+
+func TestWithTemporaryLevel(t *testing.T) {
+	defer resetLoggerConf()
+
+	t.Run("level restored", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		Configure(WithOutput(out))
+
+		WithTemporaryLevel(slog.LevelDebug, func() {
+			assert.Equal(t, slog.LevelDebug, logLevel.Level())
+			Debug("inside")
+		})
+		Debug("outside")
+
+		assert.Equal(t, slog.LevelWarn, logLevel.Level())
+		assert.Contains(t, out.String(), "inside")
+		assert.NotContains(t, out.String(), "outside")
+	})
+
+	t.Run("level restored on panic", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		Configure(WithLogLevel("error"))
+
+		assert.Panics(t, func() {
+			WithTemporaryLevel(slog.LevelDebug, func() {
+				panic("test panic")
+			})
+		})
+
+		assert.Equal(t, slog.LevelError, logLevel.Level())
+	})
+}
+
+func TestTrace(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithLogLevel("debug"))
+
+	Trace("suppressed")
+	assert.Empty(t, out.String())
+
+	WithLogLevel("trace")()
+	require.Equal(t, LevelTrace, logLevel.Level())
+
+	Trace("emitted", "key", "value")
+	assert.Contains(t, out.String(), `"level":"TRACE","msg":"emitted","key":"value"`)
+
+	out.Reset()
+	Debug("debug")
+	assert.Contains(t, out.String(), `"level":"DEBUG"`)
+
+	out.Reset()
+	Configure(WithTextFormat(), WithLowercaseLevels())
+	Trace("text")
+	assert.Contains(t, out.String(), "level=trace")
+
+	out.Reset()
+	WithLogLevel("debug")()
+	Trace("suppressed again")
+	assert.Empty(t, out.String())
+
+	out.Reset()
+	Configure(WithJSONFormat())
+	WithTemporaryLevel(LevelTrace, func() { Trace("temporary") })
+	assert.Contains(t, out.String(), `"level":"trace","msg":"temporary"`, "expected TRACE however the level is set")
+
+	out.Reset()
+	Configure(WithGCPFormat())
+	WithTemporaryLevel(LevelTrace, func() { Trace("gcp") })
+	assert.Contains(t, out.String(), `"severity":"DEBUG","message":"gcp"`)
+}
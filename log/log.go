@@ -14,53 +14,97 @@
 package log
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"io"
 	"log/slog"
 	"os"
 	"reflect"
+	"slices"
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
 func init() {
 	logLevel = new(slog.LevelVar)
 	output = os.Stdout
+	healingOutput = output
 	handler.Store(0)
 	logLevel.Set(slog.LevelWarn)
-	globalLogger = slog.New(
-		slog.NewJSONHandler(
-			output,
-			&slog.HandlerOptions{Level: logLevel},
-		),
-	)
-	globalLogger.Debug("logger init success", "log level", "warn", "output", "os.Stdout", "format", "json")
+	globalLevel.Store(logLevel)
+	globalLogger.Store(slog.New(newHandler(healingOutput, logLevel)))
+	globalLogger.Load().Debug("logger init success", "log level", "warn", "output", "os.Stdout", "format", "json")
 }
 
 // LoggingOptions represents a configuration option for the logger.
 type LoggingOptions func()
 
 var (
-	globalLogger *slog.Logger
-	logLevel     *slog.LevelVar
-	output       io.Writer
-	handler      atomic.Int64 // 0 = JSON, 1 = Text
-	mtx          sync.Mutex
+	globalLogger      atomic.Pointer[slog.Logger]
+	globalLevel       atomic.Pointer[slog.LevelVar] // level of the global logger, followed by CopyLoggerAtLevel copies
+	logLevel          *slog.LevelVar
+	output            io.Writer
+	handler           atomic.Int64 // 0 = JSON, 1 = Text, 2 = logfmt
+	mtx               sync.Mutex
+	maxAttrDepth      int             // 0 = unlimited
+	buffered          *bufferedWriter // nil = unbuffered
+	outputFunc        func(r slog.Record) io.Writer
+	lowercaseLevels   bool
+	contextExtractor  func(ctx context.Context) []slog.Attr
+	int64AsString     bool
+	recordHooks       []func(ctx context.Context, r *slog.Record)
+	maxValueLen       int // 0 = unlimited
+	defaultAttrs      []slog.Attr
+	goroutineID       bool
+	dedupWindow       time.Duration   // 0 = disabled
+	nilValue          *string         // nil = rendered as is
+	sampling          *samplingConfig // nil = disabled
+	sequence          bool
+	gcpFormat         bool
+	recordChannel     chan<- slog.Record // nil = disabled
+	destinationMarker bool
+	syslogConn        io.Closer    // connection opened by WithSyslog
+	customHandler     slog.Handler // nil = built-in format handler
+	targetFile        *os.File     // file opened by WithOutputTarget
+	targetPath        string
+	healingOutput     io.Writer // output wrapped by selfHealing, kept across rebuilds
 )
 
 // WithJSONFormat configures the logger to use JSON output format.
-// If provided alongside WithTextFormat latest provided wins
+// If provided alongside WithTextFormat latest provided wins.
+// Other options applied before, such as default attrs or ReplaceAttr-based options, are preserved.
 func WithJSONFormat() LoggingOptions {
+	return withFormat(0)
+}
+
+// WithTextFormat configures the logger to use text output format.
+// If provided alongside WithJSONFormat latest provided wins.
+// Other options applied before, such as default attrs or ReplaceAttr-based options, are preserved.
+func WithTextFormat() LoggingOptions {
+	return withFormat(1)
+}
+
+// withFormat switches the output format and rebuilds the logger from the full stored configuration.
+func withFormat(format int64) LoggingOptions {
 	return func() {
-		handler.Store(0)
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		handler.Store(format)
 		storeLogger(output)
 	}
 }
 
-// WithTextFormat configures the logger to use text output format.
-// If provided alongside WithJSONFormat latest provided wins
-func WithTextFormat() LoggingOptions {
+// WithDefaultAttrs adds attrs to every record emitted by the logger, e.g. the service name or version.
+// If provided multiple times latest provided wins.
+func WithDefaultAttrs(attrs ...slog.Attr) LoggingOptions {
 	return func() {
-		handler.Store(1)
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		defaultAttrs = slices.Clone(attrs)
 		storeLogger(output)
 	}
 }
@@ -72,18 +116,43 @@ func WithOutput(out io.Writer) LoggingOptions {
 		mtx.Lock()
 		defer mtx.Unlock()
 
-		if isNotNilOrNilPointer(out) {
-			output = out
-		} else {
-			output = os.Stdout
+		if !isNotNilOrNilPointer(out) {
+			reportInvalidOption(errors.New("output is nil"))
+			out = os.Stdout
 		}
-		storeLogger(output)
+
+		setOutput(out)
+	}
+}
+
+// setOutput replaces the output, rebuilding the buffered writer if any, and stores the logger.
+// The caller must hold mtx.
+func setOutput(out io.Writer) {
+	// While a configuration is staged, the markers are emitted when it is stored.
+	marker := destinationMarker && !staging && !sameWriter(out, output)
+	now := time.Now()
+	if marker {
+		emitDestinationMarker(now)
+	}
+
+	output = out
+	// The output is wrapped once, so its healed state survives later rebuilds of the logger.
+	healingOutput = selfHealing(out)
+	if buffered != nil {
+		_ = buffered.Flush()
+		buffered = newBufferedWriter(healingOutput, buffered.size())
+	}
+	storeLogger(output)
+
+	if marker {
+		emitDestinationMarker(now)
 	}
 }
 
 // WithLogLevel sets the log level of the logger. If an invalid value is provided, the log level defaults to "warn".
 //
 // Accepted values are:
+//   - trace: equivalent to LevelTrace
 //   - debug: equivalent to slog.LevelDebug
 //   - info:  equivalent to slog.LevelInfo
 //   - warn:  equivalent to slog.LevelWarn
@@ -91,16 +160,21 @@ func WithOutput(out io.Writer) LoggingOptions {
 func WithLogLevel(level string) LoggingOptions {
 	return func() {
 		logLevelMap := map[string]slog.Level{
+			"trace": LevelTrace,
 			"debug": slog.LevelDebug,
 			"info":  slog.LevelInfo,
 			"warn":  slog.LevelWarn,
 			"error": slog.LevelError,
 		}
 
-		if level != "debug" && level != "info" && level != "warn" && level != "error" {
+		if _, ok := logLevelMap[level]; !ok {
+			reportInvalidOption(fmt.Errorf("invalid log level %q", level))
 			level = "warn"
 		}
 
+		mtx.Lock()
+		defer mtx.Unlock()
+
 		logLevel.Set(logLevelMap[level])
 	}
 }
@@ -117,24 +191,123 @@ func CopyLogger() *slog.Logger {
 	return copyLogger()
 }
 
+// CopyLoggerTo returns a logger with the format, level, attributes and other options of the global logger,
+// writing to w instead of the global output. The global logger is left untouched.
+// If w is nil or a nil pointer, os.Stdout is used.
+func CopyLoggerTo(w io.Writer) *slog.Logger {
+	if !isNotNilOrNilPointer(w) {
+		w = os.Stdout
+	}
+
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	logLevelCopy := new(slog.LevelVar)
+	logLevelCopy.Set(logLevel.Level())
+
+	return slog.New(newHandler(selfHealing(w), logLevelCopy))
+}
+
+// CopyLoggerAtLevel returns a copy of the global logger dropping records below minLevel, even if the global
+// log level is lower, e.g. to hand a chatty third-party library a logger clamped to warn.
+// The copy follows later changes of the global log level above minLevel.
+func CopyLoggerAtLevel(minLevel slog.Level) *slog.Logger {
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	out := healingOutput
+	if buffered != nil {
+		out = buffered
+	}
+
+	return slog.New(newHandler(out, clampedLevel{level: currentLevel{}, min: minLevel}))
+}
+
+// currentLevel is a slog.Leveler reporting the level of the current global logger.
+// Swap and ConfigureAtomic replace the LevelVar along with the logger, so it is looked up on each call.
+type currentLevel struct{}
+
+func (currentLevel) Level() slog.Level {
+	return globalLevel.Load().Level()
+}
+
+// clampedLevel is a slog.Leveler reporting the higher of a dynamic level and a fixed minimum.
+type clampedLevel struct {
+	level slog.Leveler
+	min   slog.Level
+}
+
+func (l clampedLevel) Level() slog.Level {
+	return max(l.level.Level(), l.min)
+}
+
+// Trace logs a message at the LevelTrace level.
+func Trace(msg string, args ...any) {
+	globalLogger.Load().Log(context.Background(), LevelTrace, msg, args...)
+}
+
 // Debug logs a message at the slog.LevelDebug level.
 func Debug(msg string, args ...any) {
-	globalLogger.Debug(msg, args...)
+	globalLogger.Load().Debug(msg, args...)
 }
 
 // Info logs a message at the slog.LevelInfo level.
 func Info(msg string, args ...any) {
-	globalLogger.Info(msg, args...)
+	globalLogger.Load().Info(msg, args...)
 }
 
 // Warn logs a message at the slog.LevelWarn level.
 func Warn(msg string, args ...any) {
-	globalLogger.Warn(msg, args...)
+	globalLogger.Load().Warn(msg, args...)
 }
 
 // Error logs a message at the slog.LevelError level.
 func Error(msg string, args ...any) {
-	globalLogger.Error(msg, args...)
+	globalLogger.Load().Error(msg, args...)
+}
+
+// DebugContext logs a message at the slog.LevelDebug level with the given context.
+func DebugContext(ctx context.Context, msg string, args ...any) {
+	globalLogger.Load().DebugContext(ctx, msg, args...)
+}
+
+// InfoContext logs a message at the slog.LevelInfo level with the given context.
+func InfoContext(ctx context.Context, msg string, args ...any) {
+	globalLogger.Load().InfoContext(ctx, msg, args...)
+}
+
+// WarnContext logs a message at the slog.LevelWarn level with the given context.
+func WarnContext(ctx context.Context, msg string, args ...any) {
+	globalLogger.Load().WarnContext(ctx, msg, args...)
+}
+
+// ErrorContext logs a message at the slog.LevelError level with the given context.
+func ErrorContext(ctx context.Context, msg string, args ...any) {
+	globalLogger.Load().ErrorContext(ctx, msg, args...)
+}
+
+// LogAt logs a message at the given level with t as the record time instead of the current time,
+// e.g. when replaying historical events. Records below the configured level are dropped as usual.
+func LogAt(t time.Time, level slog.Level, msg string, attrs ...slog.Attr) {
+	h := globalLogger.Load().Handler()
+	if !h.Enabled(context.Background(), level) {
+		return
+	}
+
+	r := slog.NewRecord(t, level, msg, 0)
+	r.AddAttrs(attrs...)
+	_ = h.Handle(context.Background(), r)
+}
+
+// Emit passes a pre-built record to the handler of the global logger as is, preserving its time, PC and attributes,
+// e.g. for components translating their own events into records. Records below the configured level are dropped as usual.
+func Emit(ctx context.Context, r slog.Record) {
+	h := globalLogger.Load().Handler()
+	if !h.Enabled(ctx, r.Level) {
+		return
+	}
+
+	_ = h.Handle(ctx, r)
 }
 
 // isNotNilOrNilPointer checks if the provided io.Writer is not nil, a nil pointer, or a nil interface.
@@ -159,26 +332,15 @@ func copyLogger() *slog.Logger {
 	mtx.Lock()
 	defer mtx.Unlock()
 
-	outCopy := output
+	outCopy := healingOutput
+	if buffered != nil {
+		outCopy = buffered
+	}
 
 	logLevelCopy := new(slog.LevelVar)
 	logLevelCopy.Set(logLevel.Level())
 
-	if handler.Load() == 0 {
-		return slog.New(
-			slog.NewJSONHandler(
-				outCopy,
-				&slog.HandlerOptions{Level: logLevelCopy},
-			),
-		)
-	} else {
-		return slog.New(
-			slog.NewTextHandler(
-				outCopy,
-				&slog.HandlerOptions{Level: logLevelCopy},
-			),
-		)
-	}
+	return slog.New(newHandler(outCopy, logLevelCopy))
 }
 
 // storeLogger generates new *slog.Logger with supplied values and stores it as global logger
@@ -187,19 +349,112 @@ func storeLogger(out io.Writer) {
 		defer mtx.Unlock()
 	}
 
-	if handler.Load() == 0 {
-		globalLogger = slog.New(
-			slog.NewJSONHandler(
-				out,
-				&slog.HandlerOptions{Level: logLevel},
-			),
-		)
-	} else {
-		globalLogger = slog.New(
-			slog.NewTextHandler(
-				output,
-				&slog.HandlerOptions{Level: logLevel},
-			),
-		)
+	switch {
+	case buffered != nil:
+		out = buffered
+	case sameWriter(out, output):
+		out = healingOutput
+	}
+
+	if staging {
+		// Swap and ConfigureAtomic store the staged configuration once all options are applied.
+		return
+	}
+
+	globalLogger.Store(slog.New(newHandler(out, logLevel)))
+	globalLevel.Store(logLevel)
+}
+
+// newHandler builds a slog.Handler writing to out with the currently configured format and level.
+// Optional handler wrappers are applied on top of the format handler only when enabled.
+func newHandler(out io.Writer, level slog.Leveler) slog.Handler {
+	opts := &slog.HandlerOptions{Level: level, ReplaceAttr: replaceAttr()}
+
+	format := func(w io.Writer) slog.Handler {
+		switch handler.Load() {
+		case 0:
+			return slog.NewJSONHandler(w, opts)
+		case 2:
+			return newLogfmtHandler(w, opts)
+		default:
+			return slog.NewTextHandler(w, opts)
+		}
+	}
+
+	var h slog.Handler
+	switch {
+	case customHandler != nil:
+		h = &levelHandler{next: customHandler, level: level}
+	case outputFunc != nil:
+		h = newRoutingHandler(format, out, outputFunc)
+	default:
+		h = format(out)
+	}
+
+	if len(recordHooks) > 0 {
+		h = &hookHandler{next: h, hooks: recordHooks}
+	}
+	if maxAttrDepth > 0 {
+		h = &depthHandler{next: h, limit: maxAttrDepth}
+	}
+	if contextExtractor != nil {
+		h = &contextHandler{next: h, extract: contextExtractor}
+	}
+	if goroutineID {
+		h = &goidHandler{next: h}
+	}
+	if sequence {
+		h = &sequenceHandler{next: h}
+	}
+	if dedupWindow > 0 {
+		h = newDedupHandler(h, dedupWindow, recordStreak)
+	}
+	if sampling != nil {
+		h = &samplingHandler{next: h, cfg: *sampling}
+	}
+	if recordChannel != nil {
+		h = &channelHandler{next: h, ch: recordChannel, frames: []channelFrame{{}}}
+	}
+	if len(defaultAttrs) > 0 {
+		h = h.WithAttrs(defaultAttrs)
+	}
+
+	return h
+}
+
+// replaceAttr combines the enabled ReplaceAttr functions into one, applying them in a fixed order.
+// It returns nil if none is enabled, so the handler can skip the call entirely.
+func replaceAttr() func(groups []string, a slog.Attr) slog.Attr {
+	var fns []func(groups []string, a slog.Attr) slog.Attr
+	// Truncation runs first, so the message is still recognised by its original key when renamed by gcpAttr.
+	if maxValueLen > 0 {
+		fns = append(fns, truncateValue(maxValueLen))
+	}
+	if gcpFormat {
+		fns = append(fns, gcpAttr)
+	}
+	if !gcpFormat {
+		// Under the GCP format, gcpAttr has already reported LevelTrace as "DEBUG".
+		fns = append(fns, traceLevelName)
+	}
+	if lowercaseLevels {
+		fns = append(fns, lowercaseLevel)
+	}
+	if nilValue != nil {
+		fns = append(fns, replaceNil(*nilValue))
+	}
+	if int64AsString {
+		fns = append(fns, largeIntToString)
+	}
+
+	if len(fns) == 0 {
+		return nil
+	}
+
+	return func(groups []string, a slog.Attr) slog.Attr {
+		for _, fn := range fns {
+			a = fn(groups, a)
+		}
+		return a
 	}
 }
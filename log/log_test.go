@@ -2,6 +2,8 @@ package log
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"io"
@@ -9,6 +11,7 @@ import (
 	"math/rand"
 	"os"
 	"reflect"
+	"runtime"
 	"testing"
 	"time"
 	"unsafe"
@@ -29,13 +32,47 @@ func getRandomString() string {
 func resetLoggerConf() {
 	output = os.Stdout
 	handler.Store(0)
+	maxAttrDepth = 0
+	buffered = nil
+	outputFunc = nil
+	lowercaseLevels = false
+	contextExtractor = nil
+	int64AsString = false
+	recordHooks = nil
+	maxValueLen = 0
+	defaultAttrs = nil
+	goroutineID = false
+	dedupWindow = 0
+	recordStreak = &dedupState{}
+	nilValue = nil
+	sampling = nil
+	sequence = false
+	gcpFormat = false
+	recordChannel = nil
+	destinationMarker = false
+	syslogConn = nil
+	customHandler = nil
+	targetFile = nil
+	targetPath = ""
+	healingOutput = output
 	logLevel.Set(slog.LevelWarn)
-	globalLogger = slog.New(
-		slog.NewJSONHandler(
-			output,
-			&slog.HandlerOptions{Level: logLevel},
-		),
-	)
+	globalLogger.Store(slog.New(newHandler(healingOutput, logLevel)))
+	globalLevel.Store(logLevel)
+}
+
+func changeStderr() (*os.File, *os.File, func()) {
+	oldStderr := os.Stderr
+
+	r, w, _ := os.Pipe()
+	closer := func() {
+		os.Stderr = oldStderr
+		_ = r.Close()
+		_ = w.Close()
+	}
+
+	os.Stderr = w
+
+	return r, w, closer
 }
 
 func changeStdout() (*os.File, *os.File, func()) {
@@ -133,7 +170,7 @@ func TestLog_WithXXXFormat(t *testing.T) {
 
 		WithJSONFormat()()
 
-		handler := reflect.ValueOf(globalLogger.Handler())
+		handler := reflect.ValueOf(globalLogger.Load().Handler())
 		if handler.Kind() == reflect.Ptr {
 			handler = handler.Elem()
 		}
@@ -146,13 +183,36 @@ func TestLog_WithXXXFormat(t *testing.T) {
 
 		WithTextFormat()()
 
-		handler := reflect.ValueOf(globalLogger.Handler())
+		handler := reflect.ValueOf(globalLogger.Load().Handler())
 		if handler.Kind() == reflect.Ptr {
 			handler = handler.Elem()
 		}
 
 		require.Equal(t, "TextHandler", handler.Type().Name())
 	})
+
+	t.Run("preserves options", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		Configure(
+			WithOutput(out),
+			WithDefaultAttrs(slog.String("service", "api")),
+			WithLowercaseLevels(),
+			WithTextFormat(),
+		)
+		Error("text")
+
+		assert.Contains(t, out.String(), "level=error")
+		assert.Contains(t, out.String(), "service=api")
+
+		out.Reset()
+		WithJSONFormat()()
+		Error("json")
+
+		assert.Contains(t, out.String(), `"level":"error"`)
+		assert.Contains(t, out.String(), `"service":"api"`)
+	})
 }
 
 func TestLog_Configure(t *testing.T) {
@@ -163,7 +223,7 @@ func TestLog_Configure(t *testing.T) {
 
 		Configure(WithJSONFormat())
 
-		handler := reflect.ValueOf(globalLogger.Handler())
+		handler := reflect.ValueOf(globalLogger.Load().Handler())
 		if handler.Kind() == reflect.Ptr {
 			handler = handler.Elem()
 		}
@@ -176,7 +236,7 @@ func TestLog_Configure(t *testing.T) {
 
 		Configure(WithTextFormat())
 
-		handler := reflect.ValueOf(globalLogger.Handler())
+		handler := reflect.ValueOf(globalLogger.Load().Handler())
 		if handler.Kind() == reflect.Ptr {
 			handler = handler.Elem()
 		}
@@ -207,7 +267,7 @@ func TestLog_Configure(t *testing.T) {
 
 			Configure(WithOutput(nil))
 
-			handler := reflect.ValueOf(globalLogger.Handler())
+			handler := reflect.ValueOf(globalLogger.Load().Handler())
 			if handler.Kind() == reflect.Ptr {
 				handler = handler.Elem()
 			}
@@ -225,7 +285,7 @@ func TestLog_Configure(t *testing.T) {
 
 			Configure(WithOutput((*os.File)(nil)))
 
-			handler := reflect.ValueOf(globalLogger.Handler())
+			handler := reflect.ValueOf(globalLogger.Load().Handler())
 			if handler.Kind() == reflect.Ptr {
 				handler = handler.Elem()
 			}
@@ -262,7 +322,7 @@ func TestLog_Configure(t *testing.T) {
 		_, _ = io.Copy(out, r)
 		require.Contains(t, out.String(), val)
 
-		handler := reflect.ValueOf(globalLogger.Handler())
+		handler := reflect.ValueOf(globalLogger.Load().Handler())
 		if handler.Kind() == reflect.Ptr {
 			handler = handler.Elem()
 		}
@@ -364,10 +424,11 @@ func TestCopyLogger(t *testing.T) {
 
 		lg := CopyLogger()
 		require.NotNil(t, lg)
-		assert.Equal(t, globalLogger, lg)
+		// Loggers holding a ReplaceAttr function are never deeply equal, so the handler types are compared.
+		assert.IsType(t, globalLogger.Load().Handler(), lg.Handler())
 
 		Configure(WithLogLevel("debug"))
-		require.NotEqual(t, globalLogger, lg)
+		require.NotEqual(t, globalLogger.Load(), lg)
 
 		Debug("globalLogger")
 		lg.Debug("copyLogger")
@@ -392,10 +453,11 @@ func TestCopyLogger(t *testing.T) {
 
 		lg := CopyLogger()
 		require.NotNil(t, lg)
-		assert.Equal(t, globalLogger, lg)
+		// Loggers holding a ReplaceAttr function are never deeply equal, so the handler types are compared.
+		assert.IsType(t, globalLogger.Load().Handler(), lg.Handler())
 
 		Configure(WithLogLevel("info"))
-		require.NotEqual(t, globalLogger, lg)
+		require.NotEqual(t, globalLogger.Load(), lg)
 
 		Info("globalLogger")
 		lg.Info("copyLogger")
@@ -410,3 +472,105 @@ func TestCopyLogger(t *testing.T) {
 		assert.Contains(t, out.String(), "level=INFO")
 	})
 }
+
+func TestCopyLoggerTo(t *testing.T) {
+	defer resetLoggerConf()
+
+	global := &bytes.Buffer{}
+	Configure(WithOutput(global), WithTextFormat(), WithLogLevel("info"), WithDefaultAttrs(slog.String("service", "api")))
+
+	worker := &bytes.Buffer{}
+	lg := CopyLoggerTo(worker)
+	require.NotNil(t, lg)
+
+	lg.Debug("filtered")
+	lg.Info("worker")
+
+	assert.Contains(t, worker.String(), "level=INFO msg=worker service=api")
+	assert.NotContains(t, worker.String(), "filtered")
+	assert.Empty(t, global.String(), "expected the global output to be unaffected")
+	assert.Same(t, global, output, "expected the global output to be unchanged")
+
+	Info("global")
+	assert.Contains(t, global.String(), "msg=global")
+	assert.NotContains(t, worker.String(), "global")
+}
+
+func TestCopyLoggerAtLevel(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithLogLevel("debug"))
+
+	lg := CopyLoggerAtLevel(slog.LevelWarn)
+	lg.Debug("library debug")
+	lg.Info("library info")
+	lg.Warn("library warn")
+	Debug("global debug")
+
+	assert.NotContains(t, out.String(), "library debug")
+	assert.NotContains(t, out.String(), "library info")
+	assert.Contains(t, out.String(), `"msg":"library warn"`)
+	assert.Contains(t, out.String(), `"msg":"global debug"`, "expected the global level to be unaffected")
+
+	out.Reset()
+	Configure(WithLogLevel("error"))
+	lg.Warn("library warn")
+	lg.Error("library error")
+
+	assert.NotContains(t, out.String(), "library warn", "expected a higher global level to apply")
+	assert.Contains(t, out.String(), `"msg":"library error"`)
+}
+
+func TestLogAt(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out))
+
+	eventTime := time.Date(2020, time.March, 14, 15, 9, 26, 535000000, time.UTC)
+	LogAt(eventTime, slog.LevelError, "replayed", slog.String("event", "order.created"))
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(out.Bytes(), &record))
+	assert.Equal(t, "2020-03-14T15:09:26.535Z", record["time"])
+	assert.Equal(t, "ERROR", record["level"])
+	assert.Equal(t, "replayed", record["msg"])
+	assert.Equal(t, "order.created", record["event"])
+
+	out.Reset()
+	LogAt(eventTime, slog.LevelInfo, "filtered")
+	assert.Empty(t, out.String())
+}
+
+func TestEmit(t *testing.T) {
+	defer resetLoggerConf()
+
+	var pc uintptr
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithRecordHook(func(_ context.Context, r *slog.Record) {
+		pc = r.PC
+	}))
+
+	eventTime := time.Date(2020, time.March, 14, 15, 9, 26, 535000000, time.UTC)
+	var pcs [1]uintptr
+	runtime.Callers(1, pcs[:])
+	r := slog.NewRecord(eventTime, slog.LevelError, "order failed", pcs[0])
+	r.AddAttrs(slog.String("order", "42"), slog.Group("customer", slog.Int("id", 7)))
+	Emit(context.Background(), r)
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(out.Bytes(), &record))
+	assert.Equal(t, map[string]any{
+		"time":     "2020-03-14T15:09:26.535Z",
+		"level":    "ERROR",
+		"msg":      "order failed",
+		"order":    "42",
+		"customer": map[string]any{"id": float64(7)},
+	}, record)
+	assert.Equal(t, pcs[0], pc, "expected the original PC to be preserved")
+
+	out.Reset()
+	Emit(context.Background(), slog.NewRecord(eventTime, slog.LevelInfo, "filtered", 0))
+	assert.Empty(t, out.String())
+}
@@ -0,0 +1,179 @@
+package log
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"slices"
+	"strconv"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// WithLogfmt configures the logger to use canonical logfmt output format: space-separated key=value pairs,
+// with values quoted if they are empty or contain spaces, equal signs, quotes or control characters,
+// and quotes and backslashes escaped within quoted values. Keys of grouped attributes are joined with dots.
+// If provided alongside WithJSONFormat or WithTextFormat latest provided wins.
+func WithLogfmt() LoggingOptions {
+	return withFormat(2)
+}
+
+// logfmtTimeFormat is the format of time values, the same as used by slog.TextHandler.
+const logfmtTimeFormat = "2006-01-02T15:04:05.000Z07:00"
+
+// logfmtHandler is a slog.Handler writing records in logfmt.
+type logfmtHandler struct {
+	w      io.Writer
+	mu     *sync.Mutex
+	opts   slog.HandlerOptions
+	groups []string
+	prefix string // groups joined with dots, including the trailing dot
+	attrs  []byte // preformatted attributes added with WithAttrs
+}
+
+// newLogfmtHandler returns a logfmtHandler writing to w.
+func newLogfmtHandler(w io.Writer, opts *slog.HandlerOptions) *logfmtHandler {
+	h := &logfmtHandler{w: w, mu: &sync.Mutex{}}
+	if opts != nil {
+		h.opts = *opts
+	}
+	return h
+}
+
+func (h *logfmtHandler) Enabled(_ context.Context, level slog.Level) bool {
+	minLevel := slog.LevelInfo
+	if h.opts.Level != nil {
+		minLevel = h.opts.Level.Level()
+	}
+	return level >= minLevel
+}
+
+func (h *logfmtHandler) Handle(_ context.Context, r slog.Record) error {
+	buf := make([]byte, 0, 256)
+
+	if !r.Time.IsZero() {
+		buf = h.appendAttr(buf, nil, "", slog.Time(slog.TimeKey, r.Time))
+	}
+	buf = h.appendAttr(buf, nil, "", slog.Any(slog.LevelKey, r.Level))
+	buf = h.appendAttr(buf, nil, "", slog.String(slog.MessageKey, r.Message))
+	buf = append(buf, h.attrs...)
+	r.Attrs(func(a slog.Attr) bool {
+		buf = h.appendAttr(buf, h.groups, h.prefix, a)
+		return true
+	})
+	buf = append(buf, '\n')
+	if buf[0] == ' ' {
+		buf = buf[1:]
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	_, err := h.w.Write(buf)
+	return err
+}
+
+func (h *logfmtHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	h2 := *h
+	h2.attrs = slices.Clone(h.attrs)
+	for _, a := range attrs {
+		h2.attrs = h.appendAttr(h2.attrs, h.groups, h.prefix, a)
+	}
+	return &h2
+}
+
+func (h *logfmtHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	h2 := *h
+	h2.groups = append(slices.Clip(h.groups), name)
+	h2.prefix = h.prefix + name + "."
+	return &h2
+}
+
+// appendAttr appends a in logfmt to buf, preceded by a space.
+// Groups are flattened into dotted keys, empty attributes and groups are skipped.
+func (h *logfmtHandler) appendAttr(buf []byte, groups []string, prefix string, a slog.Attr) []byte {
+	a.Value = a.Value.Resolve()
+
+	if a.Value.Kind() == slog.KindGroup {
+		attrs := a.Value.Group()
+		if len(attrs) == 0 {
+			return buf
+		}
+		if a.Key != "" {
+			groups = append(slices.Clip(groups), a.Key)
+			prefix += a.Key + "."
+		}
+		for _, ga := range attrs {
+			buf = h.appendAttr(buf, groups, prefix, ga)
+		}
+		return buf
+	}
+
+	if h.opts.ReplaceAttr != nil {
+		a = h.opts.ReplaceAttr(groups, a)
+		a.Value = a.Value.Resolve()
+	}
+	if a.Equal(slog.Attr{}) {
+		return buf
+	}
+
+	buf = append(buf, ' ')
+	buf = appendLogfmtKey(buf, prefix+a.Key)
+	buf = append(buf, '=')
+	return appendLogfmtValue(buf, logfmtValue(a.Value))
+}
+
+// logfmtValue renders v as an unquoted string.
+func logfmtValue(v slog.Value) string {
+	switch v.Kind() {
+	case slog.KindTime:
+		return v.Time().Format(logfmtTimeFormat)
+	case slog.KindAny:
+		if err, ok := v.Any().(error); ok {
+			return err.Error()
+		}
+	}
+	return v.String()
+}
+
+// appendLogfmtKey appends key to buf, replacing characters not allowed in logfmt keys with underscores.
+func appendLogfmtKey(buf []byte, key string) []byte {
+	if key == "" {
+		return append(buf, '_')
+	}
+
+	for _, r := range key {
+		if r <= ' ' || r == '=' || r == '"' || r == utf8.RuneError || unicode.IsControl(r) {
+			r = '_'
+		}
+		buf = utf8.AppendRune(buf, r)
+	}
+	return buf
+}
+
+// appendLogfmtValue appends s to buf, quoted and escaped if needed.
+func appendLogfmtValue(buf []byte, s string) []byte {
+	if needsLogfmtQuoting(s) {
+		return strconv.AppendQuote(buf, s)
+	}
+	return append(buf, s...)
+}
+
+// needsLogfmtQuoting reports whether s must be quoted to be a single logfmt value.
+func needsLogfmtQuoting(s string) bool {
+	if s == "" {
+		return true
+	}
+
+	for _, r := range s {
+		if r <= ' ' || r == '=' || r == '"' || r == '\\' || r == utf8.RuneError || unicode.IsControl(r) {
+			return true
+		}
+	}
+	return false
+}
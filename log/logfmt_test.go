@@ -0,0 +1,90 @@
+package log
+
+import (
+	"bytes"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWithLogfmt(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithLogfmt())
+
+	Error("request failed",
+		slog.String("path", "/users"),
+		slog.String("reason", `bad "input" given`),
+		slog.String("query", "a=b"),
+		slog.String("empty", ""),
+		slog.String("multi\nline key", "x\ty"),
+		slog.String("backslash", `c:\temp`),
+		slog.Int("status", 500),
+		slog.Duration("took", 1500*time.Millisecond),
+		slog.Any("err", errors.New("connection reset")),
+		slog.Group("user", slog.Int("id", 42), slog.String("name", "John Doe")),
+	)
+
+	line := out.String()
+	require.True(t, strings.HasSuffix(line, "\n"))
+	assert.Equal(t, 1, strings.Count(line, "\n"))
+
+	assert.True(t, strings.HasPrefix(line, "time="))
+	assert.Contains(t, line, ` level=ERROR msg="request failed" `)
+	assert.Contains(t, line, ` path=/users `)
+	assert.Contains(t, line, ` reason="bad \"input\" given" `)
+	assert.Contains(t, line, ` query="a=b" `)
+	assert.Contains(t, line, ` empty="" `)
+	assert.Contains(t, line, ` multi_line_key="x\ty" `)
+	assert.Contains(t, line, ` backslash="c:\\temp" `)
+	assert.Contains(t, line, ` status=500 `)
+	assert.Contains(t, line, ` took=1.5s `)
+	assert.Contains(t, line, ` err="connection reset" `)
+	assert.Contains(t, line, ` user.id=42 user.name="John Doe"`)
+}
+
+func TestWithLogfmt_AttrsAndGroups(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithLogfmt(), WithLowercaseLevels())
+
+	CopyLogger().With("service", "api").WithGroup("req").With("id", 7).Error("done", "status", "ok")
+
+	assert.Contains(t, out.String(), ` level=error msg=done service=api req.id=7 req.status=ok`)
+}
+
+func TestWithLogfmt_Level(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithLogfmt())
+
+	Info("filtered")
+	assert.Empty(t, out.String())
+
+	WithLogLevel("info")()
+	Info("passed")
+	assert.Contains(t, out.String(), "msg=passed")
+}
+
+func TestWithLogfmt_FormatSwitch(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithLogfmt(), WithJSONFormat())
+
+	Error("json")
+	assert.Contains(t, out.String(), `"msg":"json"`)
+
+	out.Reset()
+	WithTextFormat()()
+	WithLogfmt()()
+	Error("logfmt")
+	assert.Contains(t, out.String(), "msg=logfmt")
+}
@@ -0,0 +1,37 @@
+package log
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"reflect"
+	"time"
+)
+
+// WithDestinationMarker makes WithOutput and WithOutputTarget emit a "log destination changed" record
+// to the old output right before switching and to the new output right after it, so operators
+// reconstructing a timeline across rotated files can find the boundary in both. Both records carry
+// the same time. Markers bypass the log level and are emitted only if the output actually changes.
+func WithDestinationMarker() LoggingOptions {
+	return func() {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		destinationMarker = true
+	}
+}
+
+// emitDestinationMarker writes a destination marker with time t through the global logger's handler,
+// regardless of the configured log level.
+func emitDestinationMarker(t time.Time) {
+	r := slog.NewRecord(t, slog.LevelInfo, "log destination changed", 0)
+	_ = globalLogger.Load().Handler().Handle(context.Background(), r)
+}
+
+// sameWriter reports whether a and b are the same writer. Writers of non-comparable types are never the same.
+func sameWriter(a, b io.Writer) bool {
+	if reflect.TypeOf(a) != reflect.TypeOf(b) || !reflect.TypeOf(a).Comparable() {
+		return false
+	}
+	return a == b
+}
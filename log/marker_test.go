@@ -0,0 +1,67 @@
+package log
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestWithDestinationMarker(t *testing.T) {
+	defer resetLoggerConf()
+
+	readRecords := func(t *testing.T, buf *bytes.Buffer) []map[string]any {
+		var records []map[string]any
+		scanner := bufio.NewScanner(buf)
+		for scanner.Scan() {
+			var record map[string]any
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+			records = append(records, record)
+		}
+		return records
+	}
+
+	t.Run("switching outputs marks both", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		oldOut, newOut := &bytes.Buffer{}, &bytes.Buffer{}
+		Configure(WithOutput(oldOut), WithDestinationMarker())
+		Warn("before")
+		Configure(WithOutput(newOut))
+		Warn("after")
+
+		oldRecords := readRecords(t, oldOut)
+		newRecords := readRecords(t, newOut)
+		require.Len(t, oldRecords, 2, "expected the old output to end with the marker")
+		require.Len(t, newRecords, 2, "expected the new output to start with the marker")
+
+		assert.Equal(t, "before", oldRecords[0]["msg"])
+		assert.Equal(t, "log destination changed", oldRecords[1]["msg"])
+		assert.Equal(t, "log destination changed", newRecords[0]["msg"])
+		assert.Equal(t, "after", newRecords[1]["msg"])
+
+		assert.NotEmpty(t, oldRecords[1]["time"], "expected the marker to carry a timestamp")
+		assert.Equal(t, oldRecords[1]["time"], newRecords[0]["time"], "expected both markers to carry the same time")
+	})
+
+	t.Run("same output is not marked", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		Configure(WithOutput(out), WithDestinationMarker(), WithOutput(out))
+
+		assert.Empty(t, out.String(), "expected no marker when the output doesn't change")
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		oldOut, newOut := &bytes.Buffer{}, &bytes.Buffer{}
+		Configure(WithOutput(oldOut), WithOutput(newOut))
+
+		assert.Empty(t, oldOut.String(), "expected no marker in the old output")
+		assert.Empty(t, newOut.String(), "expected no marker in the new output")
+	})
+}
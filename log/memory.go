@@ -0,0 +1,141 @@
+package log
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+)
+
+// MemoryRecord is a log record captured by MemorySink.
+// Attributes are resolved and flattened: attributes inside groups are keyed by their dot-separated path, e.g. "req.id".
+type MemoryRecord struct {
+	Time    time.Time
+	Level   slog.Level
+	Message string
+	Attrs   []slog.Attr
+}
+
+// Attr returns the value of the attribute with the given key and whether it was found.
+// If the key occurs several times, the last value wins, as it would in most log consumers.
+func (r MemoryRecord) Attr(key string) (slog.Value, bool) {
+	for i := len(r.Attrs) - 1; i >= 0; i-- {
+		if r.Attrs[i].Key == key {
+			return r.Attrs[i].Value, true
+		}
+	}
+	return slog.Value{}, false
+}
+
+// MemorySink is a slog.Handler storing every record in memory, intended for asserting on logging behavior in tests
+// without matching formatted output. It captures records of all levels and is safe for concurrent use.
+//
+// Example usage:
+//
+//	sink := log.NewMemorySink()
+//	logger := slog.New(sink)
+//	logger.Info("user created", "id", 42)
+//
+//	rec, _ := sink.Find("user created")
+//	id, _ := rec.Attr("id") // id.Int64() == 42
+type MemorySink struct {
+	mu      *sync.Mutex
+	records *[]MemoryRecord
+	attrs   []slog.Attr
+	prefix  string
+}
+
+// NewMemorySink returns an empty MemorySink.
+func NewMemorySink() *MemorySink {
+	return &MemorySink{mu: &sync.Mutex{}, records: &[]MemoryRecord{}}
+}
+
+// Records returns a copy of all captured records in the order they were handled.
+func (s *MemorySink) Records() []MemoryRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(*s.records)
+}
+
+// Find returns the first captured record with the given message and whether it was found.
+func (s *MemorySink) Find(msg string) (MemoryRecord, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, r := range *s.records {
+		if r.Message == msg {
+			return r, true
+		}
+	}
+	return MemoryRecord{}, false
+}
+
+// Reset removes all captured records.
+func (s *MemorySink) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	*s.records = (*s.records)[:0]
+}
+
+func (s *MemorySink) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+func (s *MemorySink) Handle(_ context.Context, r slog.Record) error {
+	attrs := slices.Clone(s.attrs)
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = appendFlattened(attrs, s.prefix, a)
+		return true
+	})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	*s.records = append(*s.records, MemoryRecord{Time: r.Time, Level: r.Level, Message: r.Message, Attrs: attrs})
+	return nil
+}
+
+func (s *MemorySink) WithAttrs(attrs []slog.Attr) slog.Handler {
+	res := s.clone()
+	for _, a := range attrs {
+		res.attrs = appendFlattened(res.attrs, s.prefix, a)
+	}
+	return res
+}
+
+func (s *MemorySink) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return s
+	}
+
+	res := s.clone()
+	res.prefix = s.prefix + name + "."
+	return res
+}
+
+func (s *MemorySink) clone() *MemorySink {
+	return &MemorySink{mu: s.mu, records: s.records, attrs: slices.Clip(s.attrs), prefix: s.prefix}
+}
+
+// appendFlattened resolves a and appends it to attrs with its key prefixed by prefix.
+// Groups are expanded into their members, empty attributes are skipped, as slog handlers do.
+func appendFlattened(attrs []slog.Attr, prefix string, a slog.Attr) []slog.Attr {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return attrs
+	}
+
+	if a.Value.Kind() != slog.KindGroup {
+		return append(attrs, slog.Attr{Key: prefix + a.Key, Value: a.Value})
+	}
+
+	groupPrefix := prefix
+	if a.Key != "" {
+		groupPrefix = prefix + a.Key + "."
+	}
+	for _, ga := range a.Value.Group() {
+		attrs = appendFlattened(attrs, groupPrefix, ga)
+	}
+	return attrs
+}
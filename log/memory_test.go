@@ -0,0 +1,109 @@
+package log
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"testing"
+)
+
+func TestMemorySink(t *testing.T) {
+	t.Run("assert on attribute value", func(t *testing.T) {
+		sink := NewMemorySink()
+		logger := slog.New(sink)
+
+		logger.Info("user created", "id", 42, slog.Group("req", slog.String("method", "POST")))
+
+		rec, ok := sink.Find("user created")
+		require.True(t, ok)
+		assert.Equal(t, slog.LevelInfo, rec.Level)
+
+		id, ok := rec.Attr("id")
+		require.True(t, ok)
+		assert.Equal(t, int64(42), id.Int64())
+
+		method, ok := rec.Attr("req.method")
+		require.True(t, ok)
+		assert.Equal(t, "POST", method.String())
+	})
+
+	t.Run("WithAttrs and WithGroup", func(t *testing.T) {
+		sink := NewMemorySink()
+		logger := slog.New(sink).With("service", "api").WithGroup("http")
+
+		logger.Debug("request", "status", 200)
+
+		records := sink.Records()
+		require.Len(t, records, 1)
+		assert.Equal(t, slog.LevelDebug, records[0].Level)
+
+		service, ok := records[0].Attr("service")
+		require.True(t, ok)
+		assert.Equal(t, "api", service.String())
+
+		status, ok := records[0].Attr("http.status")
+		require.True(t, ok)
+		assert.Equal(t, int64(200), status.Int64())
+	})
+
+	t.Run("Find and Reset", func(t *testing.T) {
+		sink := NewMemorySink()
+		logger := slog.New(sink)
+
+		logger.Warn("first")
+		logger.Error("second")
+
+		_, ok := sink.Find("missing")
+		assert.False(t, ok)
+		assert.Len(t, sink.Records(), 2)
+
+		sink.Reset()
+		assert.Empty(t, sink.Records())
+	})
+}
+
+func TestWithHandler(t *testing.T) {
+	defer resetLoggerConf()
+
+	t.Run("global logger records reach the sink", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		sink := NewMemorySink()
+		Configure(WithHandler(sink), WithLogLevel("info"), WithDefaultAttrs(slog.String("svc", "api")))
+
+		Debug("dropped")
+		Info("user created", "id", 42)
+		Audit("alice", "delete", "doc/1", "success")
+
+		_, ok := sink.Find("dropped")
+		assert.False(t, ok, "expected records below the configured level to be dropped")
+
+		rec, ok := sink.Find("user created")
+		require.True(t, ok)
+		assert.Equal(t, slog.LevelInfo, rec.Level)
+		id, ok := rec.Attr("id")
+		require.True(t, ok)
+		assert.Equal(t, int64(42), id.Int64())
+		svc, ok := rec.Attr("svc")
+		require.True(t, ok, "expected default attrs to apply")
+		assert.Equal(t, "api", svc.String())
+
+		rec, ok = sink.Find("audit")
+		require.True(t, ok, "expected audit records to reach the sink")
+		actor, _ := rec.Attr("actor")
+		assert.Equal(t, "alice", actor.String())
+	})
+
+	t.Run("nil restores the format handler", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		sink := NewMemorySink()
+		out := &bytes.Buffer{}
+		Configure(WithOutput(out), WithHandler(sink), WithHandler(nil))
+
+		Warn("formatted")
+		assert.Empty(t, sink.Records())
+		assert.Contains(t, out.String(), `"msg":"formatted"`)
+	})
+}
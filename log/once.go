@@ -0,0 +1,29 @@
+package log
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+)
+
+// onceKeys is the set of keys already logged by Once.
+var onceKeys sync.Map
+
+// Once logs a message at the given level only the first time key is seen, e.g. for deprecation warnings
+// and one-time configuration notices. Calls with a key whose record is filtered by the configured log level
+// don't consume it, so the record is still emitted once the level allows it. Safe for concurrent use.
+func Once(key string, level slog.Level, msg string, args ...any) {
+	logger := globalLogger.Load()
+	if !logger.Enabled(context.Background(), level) {
+		return
+	}
+	if _, seen := onceKeys.LoadOrStore(key, struct{}{}); seen {
+		return
+	}
+	logger.Log(context.Background(), level, msg, args...)
+}
+
+// ResetOnce forgets the keys logged by Once, so each of them is logged again. Intended for tests.
+func ResetOnce() {
+	onceKeys.Clear()
+}
@@ -0,0 +1,67 @@
+package log
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestOnce(t *testing.T) {
+	defer resetLoggerConf()
+	defer ResetOnce()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out))
+
+	Once("k", slog.LevelWarn, "deprecated option", "option", "foo")
+	Once("k", slog.LevelWarn, "deprecated option", "option", "foo")
+	Once("other", slog.LevelError, "other notice")
+
+	assert.Equal(t, 1, strings.Count(out.String(), `"msg":"deprecated option"`), "expected the record to be emitted once")
+	assert.Contains(t, out.String(), `"option":"foo"`)
+	assert.Equal(t, 1, strings.Count(out.String(), `"msg":"other notice"`), "expected keys to be tracked separately")
+
+	out.Reset()
+	ResetOnce()
+	Once("k", slog.LevelWarn, "deprecated option")
+	assert.Contains(t, out.String(), `"msg":"deprecated option"`, "expected the key to be logged again after ResetOnce")
+}
+
+func TestOnce_FilteredLevel(t *testing.T) {
+	defer resetLoggerConf()
+	defer ResetOnce()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out))
+
+	Once("k", slog.LevelInfo, "notice")
+	assert.Empty(t, out.String())
+
+	Configure(WithLogLevel("info"))
+	Once("k", slog.LevelInfo, "notice")
+	Once("k", slog.LevelInfo, "notice")
+	assert.Equal(t, 1, strings.Count(out.String(), `"msg":"notice"`), "expected a filtered call not to consume the key")
+}
+
+func TestOnce_Concurrent(t *testing.T) {
+	defer resetLoggerConf()
+	defer ResetOnce()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out))
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Once("k", slog.LevelError, "concurrent")
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, strings.Count(out.String(), `"msg":"concurrent"`))
+}
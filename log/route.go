@@ -0,0 +1,140 @@
+package log
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+)
+
+// WithOutputFunc routes each record to the writer returned by fn, allowing per-record destinations
+// (e.g. per tenant). If fn returns nil, the record is written to the configured output (os.Stdout by default).
+// Records are still formatted with the configured format. Passing a nil fn disables routing.
+// WithOutputFunc replaces the routing set by WithSyslog, closing its connection.
+func WithOutputFunc(fn func(r slog.Record) io.Writer) LoggingOptions {
+	return func() {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		if syslogConn != nil {
+			// fn replaces the routing set by WithSyslog.
+			release(syslogConn)
+			syslogConn = nil
+		}
+		outputFunc = fn
+		storeLogger(output)
+	}
+}
+
+// routingHandler is a slog.Handler selecting the destination writer for every record.
+// The underlying format handler writes into a shared routeWriter whose destination is switched
+// under a mutex right before the record is handled.
+type routingHandler struct {
+	next slog.Handler
+	w    *routeWriter
+	fn   func(r slog.Record) io.Writer
+}
+
+// newRoutingHandler returns a routingHandler formatting records with format and writing them to
+// the writer returned by fn, or to fallback if fn returns nil.
+func newRoutingHandler(format func(w io.Writer) slog.Handler, fallback io.Writer, fn func(r slog.Record) io.Writer) *routingHandler {
+	w := &routeWriter{fallback: fallback, dst: fallback}
+	return &routingHandler{next: format(w), w: w, fn: fn}
+}
+
+func (h *routingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *routingHandler) Handle(ctx context.Context, r slog.Record) error {
+	dst := h.fn(r)
+	if t, ok := dst.(teeWriter); ok {
+		dst = io.MultiWriter(h.w.fallback, t.w)
+	} else if !isNotNilOrNilPointer(dst) {
+		dst = h.w.fallback
+	}
+
+	h.w.mu.Lock()
+	defer h.w.mu.Unlock()
+
+	h.w.dst = dst
+	return h.next.Handle(ctx, r)
+}
+
+func (h *routingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &routingHandler{next: h.next.WithAttrs(attrs), w: h.w, fn: h.fn}
+}
+
+func (h *routingHandler) WithGroup(name string) slog.Handler {
+	return &routingHandler{next: h.next.WithGroup(name), w: h.w, fn: h.fn}
+}
+
+// routeWriter writes to the destination selected by routingHandler.
+type routeWriter struct {
+	mu       sync.Mutex
+	fallback io.Writer
+	dst      io.Writer
+}
+
+func (w *routeWriter) Write(p []byte) (int, error) {
+	return w.dst.Write(p)
+}
+
+// WithLevelRouter dispatches each record to the writer registered for its level in routes,
+// or to fallback for levels without a route. If fallback is nil, the configured output is used.
+// Records are still formatted with the configured format.
+// To duplicate records, e.g. errors into both the main output and an "errors only" file,
+// use WithLevelFanout or register an io.MultiWriter for the level.
+//
+// WithLevelRouter replaces any function set by WithOutputFunc.
+func WithLevelRouter(routes map[slog.Level]io.Writer, fallback io.Writer) LoggingOptions {
+	routesCopy := maps.Clone(routes)
+
+	return WithOutputFunc(func(r slog.Record) io.Writer {
+		if w, ok := routesCopy[r.Level]; ok {
+			return w
+		}
+		return fallback
+	})
+}
+
+// WithLevelFanout writes every record to fallback and, in addition, each record at or above a level in routes
+// to the writer registered for the highest such level, e.g. to keep errors in the main output and copy them
+// to an "errors only" file. If fallback is nil, the configured output is used.
+// Records are still formatted with the configured format.
+//
+// WithLevelFanout replaces any function set by WithOutputFunc.
+func WithLevelFanout(routes map[slog.Level]io.Writer, fallback io.Writer) LoggingOptions {
+	levels := slices.Sorted(maps.Keys(routes))
+	slices.Reverse(levels)
+
+	// Records are written to fallback first, then to the route.
+	fanout := make(map[slog.Level]io.Writer, len(routes))
+	for level, w := range routes {
+		if fallback == nil {
+			fanout[level] = teeWriter{w: w}
+		} else {
+			fanout[level] = io.MultiWriter(fallback, w)
+		}
+	}
+
+	return WithOutputFunc(func(r slog.Record) io.Writer {
+		for _, level := range levels {
+			if r.Level >= level {
+				return fanout[level]
+			}
+		}
+		return fallback
+	})
+}
+
+// teeWriter is returned by an output function to write a record to both the configured output and w.
+type teeWriter struct {
+	w io.Writer
+}
+
+func (t teeWriter) Write(p []byte) (int, error) {
+	return t.w.Write(p)
+}
@@ -0,0 +1,139 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestWithOutputFunc(t *testing.T) {
+	defer resetLoggerConf()
+
+	t.Run("route by level", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		errorsOut := &bytes.Buffer{}
+		otherOut := &bytes.Buffer{}
+		Configure(WithLogLevel("debug"), WithOutputFunc(func(r slog.Record) io.Writer {
+			if r.Level >= slog.LevelError {
+				return errorsOut
+			}
+			return otherOut
+		}))
+
+		Error("error record")
+		Warn("warn record")
+		globalLogger.Load().With("k", "v").Info("info record")
+
+		assert.Contains(t, errorsOut.String(), "error record")
+		assert.NotContains(t, errorsOut.String(), "warn record")
+		assert.NotContains(t, errorsOut.String(), "info record")
+
+		assert.Contains(t, otherOut.String(), "warn record")
+		assert.Contains(t, otherOut.String(), `"msg":"info record","k":"v"`)
+		assert.NotContains(t, otherOut.String(), "error record")
+	})
+
+	t.Run("nil writer falls back to output", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		Configure(WithOutput(out), WithTextFormat(), WithOutputFunc(func(r slog.Record) io.Writer {
+			return nil
+		}))
+
+		Error("fallback record")
+
+		assert.True(t, strings.HasPrefix(out.String(), "time="))
+		assert.Contains(t, out.String(), "fallback record")
+	})
+}
+
+func TestWithLevelRouter(t *testing.T) {
+	defer resetLoggerConf()
+
+	t.Run("error duplicated to error sink", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		mainOut := &bytes.Buffer{}
+		errorsOut := &bytes.Buffer{}
+		Configure(WithLogLevel("debug"), WithTextFormat(), WithLevelRouter(map[slog.Level]io.Writer{
+			slog.LevelError: io.MultiWriter(mainOut, errorsOut),
+		}, mainOut))
+
+		Error("error record")
+		Debug("debug record")
+
+		assert.Contains(t, mainOut.String(), "error record")
+		assert.Contains(t, mainOut.String(), "debug record")
+		assert.Contains(t, errorsOut.String(), "level=ERROR msg=\"error record\"")
+		assert.NotContains(t, errorsOut.String(), "debug record")
+	})
+
+	t.Run("nil fallback uses output", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		warnOut := &bytes.Buffer{}
+		Configure(WithOutput(out), WithLevelRouter(map[slog.Level]io.Writer{slog.LevelWarn: warnOut}, nil))
+
+		Warn("warn record")
+		Error("error record")
+
+		assert.Contains(t, warnOut.String(), "warn record")
+		assert.NotContains(t, warnOut.String(), "error record")
+		assert.Contains(t, out.String(), "error record")
+		assert.NotContains(t, out.String(), "warn record")
+	})
+}
+
+func TestWithLevelFanout(t *testing.T) {
+	defer resetLoggerConf()
+
+	t.Run("error in both sinks", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		mainOut := &bytes.Buffer{}
+		errorsOut := &bytes.Buffer{}
+		Configure(WithLogLevel("debug"), WithTextFormat(), WithLevelFanout(map[slog.Level]io.Writer{
+			slog.LevelError: errorsOut,
+		}, mainOut))
+
+		Error("error record")
+		Info("info record")
+
+		assert.Contains(t, mainOut.String(), "level=ERROR msg=\"error record\"")
+		assert.Contains(t, mainOut.String(), "info record")
+		assert.Contains(t, errorsOut.String(), "level=ERROR msg=\"error record\"")
+		assert.NotContains(t, errorsOut.String(), "info record")
+	})
+
+	t.Run("highest route at or below the level", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		out := &bytes.Buffer{}
+		warnOut := &bytes.Buffer{}
+		errorsOut := &bytes.Buffer{}
+		Configure(WithOutput(out), WithLevelFanout(map[slog.Level]io.Writer{
+			slog.LevelWarn:  warnOut,
+			slog.LevelError: errorsOut,
+		}, nil))
+
+		Warn("warn record")
+		Error("error record")
+		CopyLogger().Log(context.Background(), slog.LevelError+4, "critical record")
+
+		assert.Contains(t, out.String(), "warn record", "expected the configured output to get every record")
+		assert.Contains(t, out.String(), "error record")
+		assert.Contains(t, out.String(), "critical record")
+		assert.Contains(t, warnOut.String(), "warn record")
+		assert.NotContains(t, warnOut.String(), "error record")
+		assert.Contains(t, errorsOut.String(), "error record")
+		assert.Contains(t, errorsOut.String(), "critical record")
+		assert.NotContains(t, errorsOut.String(), "warn record")
+	})
+}
@@ -0,0 +1,57 @@
+package log
+
+import (
+	"context"
+	"log/slog"
+	"math/rand/v2"
+)
+
+// samplingConfig holds the configuration of WithProbabilisticSampling.
+type samplingConfig struct {
+	level slog.Level
+	rate  float64
+}
+
+// WithProbabilisticSampling emits records at or below level only with probability rate, e.g. 0.01
+// to keep 1% of debug records in production. Records above level always pass. The rate is clamped to [0, 1];
+// a rate of 1 disables sampling. Sampling happens after level filtering, so records must also be enabled
+// by the configured log level.
+func WithProbabilisticSampling(level slog.Level, rate float64) LoggingOptions {
+	return func() {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		rate = min(max(rate, 0), 1)
+		if rate == 1 {
+			sampling = nil
+		} else {
+			sampling = &samplingConfig{level: level, rate: rate}
+		}
+		storeLogger(output)
+	}
+}
+
+// samplingHandler is a slog.Handler dropping records at or below a level at random.
+type samplingHandler struct {
+	next slog.Handler
+	cfg  samplingConfig
+}
+
+func (h *samplingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *samplingHandler) Handle(ctx context.Context, r slog.Record) error {
+	if r.Level <= h.cfg.level && !isAudit(ctx) && rand.Float64() >= h.cfg.rate {
+		return nil
+	}
+	return h.next.Handle(ctx, r)
+}
+
+func (h *samplingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &samplingHandler{next: h.next.WithAttrs(attrs), cfg: h.cfg}
+}
+
+func (h *samplingHandler) WithGroup(name string) slog.Handler {
+	return &samplingHandler{next: h.next.WithGroup(name), cfg: h.cfg}
+}
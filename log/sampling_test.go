@@ -0,0 +1,56 @@
+package log
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestWithProbabilisticSampling(t *testing.T) {
+	defer resetLoggerConf()
+
+	tests := []struct {
+		name          string
+		rate          float64
+		expectedDebug int
+	}{
+		{"rate 0", 0, 0},
+		{"negative rate", -1, 0},
+		{"rate 1", 1, 100},
+		{"rate above 1", 2, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			Configure(WithOutput(out), WithLogLevel("debug"), WithProbabilisticSampling(slog.LevelDebug, tt.rate))
+
+			for range 100 {
+				Debug("debug")
+				Error("error")
+			}
+
+			assert.Equal(t, tt.expectedDebug, strings.Count(out.String(), `"msg":"debug"`))
+			assert.Equal(t, 100, strings.Count(out.String(), `"msg":"error"`), "expected records above the level to always pass")
+		})
+	}
+}
+
+func TestWithProbabilisticSampling_Rate(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithLogLevel("debug"), WithProbabilisticSampling(slog.LevelInfo, 0.5))
+
+	for range 1000 {
+		Info("info")
+		Warn("warn")
+	}
+
+	sampled := strings.Count(out.String(), `"msg":"info"`)
+	assert.Greater(t, sampled, 300, "expected about half of the records to pass")
+	assert.Less(t, sampled, 700, "expected about half of the records to pass")
+	assert.Equal(t, 1000, strings.Count(out.String(), `"msg":"warn"`))
+}
@@ -0,0 +1,47 @@
+package log
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+)
+
+// recordSeq is the number of records tagged by WithSequence since the process started.
+var recordSeq atomic.Uint64
+
+// WithSequence attaches a "seq" attribute holding a process-wide sequence number, incremented atomically
+// for every emitted record across all levels, so gaps reveal records dropped by a lossy pipeline.
+// Records dropped by sampling or deduplication don't consume a number. The sequence survives
+// reconfiguration but isn't persisted: it restarts from 1 when the process restarts.
+func WithSequence() LoggingOptions {
+	return func() {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		sequence = true
+		storeLogger(output)
+	}
+}
+
+// sequenceHandler is a slog.Handler appending the next sequence number.
+type sequenceHandler struct {
+	next slog.Handler
+}
+
+func (h *sequenceHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *sequenceHandler) Handle(ctx context.Context, r slog.Record) error {
+	r = r.Clone()
+	r.AddAttrs(slog.Uint64("seq", recordSeq.Add(1)))
+	return h.next.Handle(ctx, r)
+}
+
+func (h *sequenceHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &sequenceHandler{next: h.next.WithAttrs(attrs)}
+}
+
+func (h *sequenceHandler) WithGroup(name string) slog.Handler {
+	return &sequenceHandler{next: h.next.WithGroup(name)}
+}
@@ -0,0 +1,56 @@
+package log
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"testing"
+)
+
+func TestWithSequence(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithLogLevel("debug"), WithSequence())
+
+	Debug("one")
+	Info("two")
+	Debug("three")
+	Warn("four")
+	Error("five")
+	CopyLogger().WithGroup("g").Error("six")
+	Configure(WithTextFormat(), WithJSONFormat())
+	Error("seven")
+
+	var seqs []float64
+	scanner := bufio.NewScanner(out)
+	for scanner.Scan() {
+		var record map[string]any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		seq, ok := record["seq"].(float64)
+		if !ok {
+			group, _ := record["g"].(map[string]any)
+			seq, ok = group["seq"].(float64)
+		}
+		require.True(t, ok, "expected every record to carry a sequence number")
+		seqs = append(seqs, seq)
+	}
+
+	require.Len(t, seqs, 7)
+	for i := 1; i < len(seqs); i++ {
+		assert.Equal(t, seqs[i-1]+1, seqs[i], "expected the sequence to increment by one")
+	}
+}
+
+func TestWithSequence_Disabled(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out))
+
+	Error("no seq", slog.Int("n", 1))
+	assert.NotContains(t, out.String(), `"seq"`)
+}
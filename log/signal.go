@@ -0,0 +1,38 @@
+package log
+
+import (
+	"os"
+	"os/signal"
+	"strings"
+)
+
+// InstallSignalReload reconfigures the global logger each time the process receives sig.
+// On every signal, reload is called and the returned options are applied via Configure.
+// If reload is nil, ReloadFromEnv is used.
+//
+// The handler stays installed for the lifetime of the process.
+//
+// Example usage:
+//
+//	log.InstallSignalReload(syscall.SIGHUP, log.ReloadFromEnv)
+func InstallSignalReload(sig os.Signal, reload func() []LoggingOptions) {
+	if reload == nil {
+		reload = ReloadFromEnv
+	}
+
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, sig)
+
+	go func() {
+		for range ch {
+			Configure(reload()...)
+		}
+	}()
+}
+
+// ReloadFromEnv re-reads the LOG_LEVEL environment variable and returns the matching options.
+// It is the default reload function used by InstallSignalReload.
+// An unset or invalid LOG_LEVEL falls back to "warn" as described in WithLogLevel.
+func ReloadFromEnv() []LoggingOptions {
+	return []LoggingOptions{WithLogLevel(strings.ToLower(os.Getenv("LOG_LEVEL")))}
+}
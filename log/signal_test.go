@@ -0,0 +1,53 @@
+//go:build !windows
+
+package log
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+var (
+	installSignalReload sync.Once
+	signalReloaded      = make(chan struct{})
+)
+
+func TestInstallSignalReload(t *testing.T) {
+	defer resetLoggerConf()
+
+	t.Setenv("LOG_LEVEL", "DEBUG")
+	assert.Equal(t, slog.LevelWarn, logLevel.Level())
+
+	// The handler stays installed for the lifetime of the process, so it is installed once across test runs.
+	installSignalReload.Do(func() {
+		InstallSignalReload(syscall.SIGHUP, func() []LoggingOptions {
+			// The last option signals that the reload has been applied completely.
+			return append(ReloadFromEnv(), func() { signalReloaded <- struct{}{} })
+		})
+	})
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGHUP))
+
+	select {
+	case <-signalReloaded:
+	case <-time.After(time.Second):
+		require.FailNow(t, "expected the reload to complete")
+	}
+	assert.Equal(t, slog.LevelDebug, logLevel.Level())
+}
+
+func TestReloadFromEnv(t *testing.T) {
+	defer resetLoggerConf()
+
+	t.Setenv("LOG_LEVEL", "error")
+	Configure(ReloadFromEnv()...)
+	require.Equal(t, slog.LevelError, logLevel.Level())
+
+	t.Setenv("LOG_LEVEL", "")
+	Configure(ReloadFromEnv()...)
+	require.Equal(t, slog.LevelWarn, logLevel.Level())
+}
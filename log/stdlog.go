@@ -0,0 +1,45 @@
+package log
+
+import (
+	"context"
+	stdlog "log"
+	"log/slog"
+)
+
+// RedirectStdLog routes the output of the standard library log package through the global logger,
+// so messages logged by third-party libraries via log.Print and friends become structured records
+// at slog.LevelInfo. Records follow later reconfigurations of the global logger.
+// It returns a function restoring the previous output, flags and prefix of the standard logger.
+func RedirectStdLog() func() {
+	prevOutput, prevFlags, prevPrefix := stdlog.Writer(), stdlog.Flags(), stdlog.Prefix()
+
+	redirected := slog.NewLogLogger(globalHandler{}, slog.LevelInfo)
+	stdlog.SetOutput(redirected.Writer())
+	stdlog.SetFlags(0)
+	stdlog.SetPrefix("")
+
+	return func() {
+		stdlog.SetOutput(prevOutput)
+		stdlog.SetFlags(prevFlags)
+		stdlog.SetPrefix(prevPrefix)
+	}
+}
+
+// globalHandler is a slog.Handler delegating to the handler of the current global logger.
+type globalHandler struct{}
+
+func (globalHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return globalLogger.Load().Handler().Enabled(ctx, level)
+}
+
+func (globalHandler) Handle(ctx context.Context, r slog.Record) error {
+	return globalLogger.Load().Handler().Handle(ctx, r)
+}
+
+func (globalHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return globalLogger.Load().Handler().WithAttrs(attrs)
+}
+
+func (globalHandler) WithGroup(name string) slog.Handler {
+	return globalLogger.Load().Handler().WithGroup(name)
+}
@@ -0,0 +1,38 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	stdlog "log"
+	"os"
+	"testing"
+)
+
+func TestRedirectStdLog(t *testing.T) {
+	defer resetLoggerConf()
+
+	out := &bytes.Buffer{}
+	Configure(WithOutput(out), WithLogLevel("info"))
+
+	restore := RedirectStdLog()
+	stdlog.Print("legacy message")
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(out.Bytes(), &record))
+	assert.Equal(t, "INFO", record["level"])
+	assert.Equal(t, "legacy message", record["msg"])
+
+	out.Reset()
+	other := &bytes.Buffer{}
+	Configure(WithOutput(other))
+	stdlog.Printf("after %s", "reconfiguration")
+
+	assert.Empty(t, out.String())
+	assert.Contains(t, other.String(), `"msg":"after reconfiguration"`)
+
+	restore()
+	assert.Equal(t, os.Stderr, stdlog.Writer())
+	assert.Equal(t, stdlog.LstdFlags, stdlog.Flags())
+}
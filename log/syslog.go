@@ -0,0 +1,77 @@
+//go:build !windows && !plan9
+
+package log
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"log/syslog"
+	"os"
+)
+
+// WithSyslog routes records to the syslog server at addr using the log/syslog package.
+// network and addr are passed to syslog.Dial; an empty network connects to the local syslog server.
+// Records are formatted with the configured format and sent with a severity matching their level:
+//   - below slog.LevelInfo:  LOG_DEBUG
+//   - below slog.LevelWarn:  LOG_INFO
+//   - below slog.LevelError: LOG_WARNING
+//   - otherwise:             LOG_ERR
+//
+// WithSyslog replaces any function set by WithOutputFunc.
+//
+// If the connection fails, the output falls back to os.Stdout like WithOutput(os.Stdout), a function set by
+// WithOutputFunc is kept, and a warning is written to os.Stderr. ConfigureAtomic reports the failure instead.
+func WithSyslog(network, addr, tag string) LoggingOptions {
+	return func() {
+		w, err := syslog.Dial(network, addr, syslog.LOG_USER|syslog.LOG_INFO, tag)
+
+		mtx.Lock()
+		if syslogConn != nil {
+			// The output function of the previous WithSyslog writes to the released connection.
+			release(syslogConn)
+			syslogConn = nil
+			outputFunc = nil
+		}
+		if err != nil {
+			reportInvalidOption(fmt.Errorf("syslog unavailable at %q: %w", addr, err))
+			setOutput(os.Stdout)
+		} else {
+			acquire(w)
+			syslogConn = w
+			outputFunc = syslogOutputFunc(w)
+			storeLogger(output)
+		}
+		mtx.Unlock()
+
+		if err != nil {
+			warnFallback("syslog unavailable, falling back to os.Stdout", "network", network, "addr", addr, "error", err)
+		}
+	}
+}
+
+// syslogOutputFunc returns an output function writing each record to w with the severity matching its level.
+func syslogOutputFunc(w *syslog.Writer) func(r slog.Record) io.Writer {
+	return func(r slog.Record) io.Writer {
+		switch {
+		case r.Level < slog.LevelInfo:
+			return syslogWriter(w.Debug)
+		case r.Level < slog.LevelWarn:
+			return syslogWriter(w.Info)
+		case r.Level < slog.LevelError:
+			return syslogWriter(w.Warning)
+		default:
+			return syslogWriter(w.Err)
+		}
+	}
+}
+
+// syslogWriter adapts a syslog.Writer severity method to io.Writer.
+type syslogWriter func(m string) error
+
+func (fn syslogWriter) Write(p []byte) (int, error) {
+	if err := fn(string(p)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,22 @@
+//go:build windows || plan9
+
+package log
+
+import (
+	"errors"
+	"os"
+)
+
+// WithSyslog is not supported on this platform, as log/syslog isn't available.
+// The output falls back to os.Stdout like WithOutput(os.Stdout), a function set by WithOutputFunc is kept,
+// and a warning is written to os.Stderr. ConfigureAtomic reports the failure instead.
+func WithSyslog(network, addr, tag string) LoggingOptions {
+	return func() {
+		mtx.Lock()
+		reportInvalidOption(errors.New("syslog is not supported on this platform"))
+		setOutput(os.Stdout)
+		mtx.Unlock()
+
+		warnFallback("syslog is not supported on this platform, falling back to os.Stdout", "network", network, "addr", addr)
+	}
+}
@@ -0,0 +1,110 @@
+//go:build !windows && !plan9
+
+package log
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"log/slog"
+	"net"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestWithSyslog(t *testing.T) {
+	defer resetLoggerConf()
+
+	t.Run("record received", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer func() { _ = conn.Close() }()
+
+		Configure(WithSyslog("udp", conn.LocalAddr().String(), "test-app"))
+		defer func() { _ = syslogConn.Close() }()
+
+		val := getRandomString()
+		Error(val)
+
+		buf := make([]byte, 4096)
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+		n, _, err := conn.ReadFrom(buf)
+		require.NoError(t, err)
+
+		msg := string(buf[:n])
+		assert.Contains(t, msg, "<11>") // LOG_USER | LOG_ERR
+		assert.Contains(t, msg, "test-app")
+		assert.Contains(t, msg, val)
+		assert.Contains(t, msg, "\"level\":\"ERROR\"")
+	})
+
+	t.Run("fallback to stdout", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		r, w, closer := changeStderr()
+		defer closer()
+
+		Configure(WithLogLevel("error"), WithSyslog("invalid", "127.0.0.1:0", "test-app"))
+		assert.Equal(t, os.Stdout, output)
+		assert.Nil(t, outputFunc)
+
+		_ = w.Close()
+		buf := make([]byte, 4096)
+		n, _ := r.Read(buf)
+		assert.Contains(t, string(buf[:n]), "syslog unavailable", "expected the warning on stderr regardless of the level")
+	})
+
+	t.Run("fallback keeps the output function and buffering", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		_, _, closer := changeStderr()
+		defer closer()
+
+		routed := &bytes.Buffer{}
+		Configure(
+			WithOutput(&bytes.Buffer{}),
+			WithBufferedOutput(4096),
+			WithOutputFunc(func(r slog.Record) io.Writer { return routed }),
+		)
+		before := buffered
+
+		Configure(WithSyslog("invalid", "127.0.0.1:0", "test-app"))
+		require.NotNil(t, outputFunc, "expected the output function to be kept")
+		assert.Equal(t, os.Stdout, output)
+		require.NotNil(t, buffered)
+		assert.NotSame(t, before, buffered, "expected the buffer to be rebuilt over the fallback output")
+
+		Error("routed")
+		assert.Contains(t, routed.String(), "routed")
+	})
+
+	t.Run("output function replaces syslog", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer func() { _ = conn.Close() }()
+
+		Configure(WithSyslog("udp", conn.LocalAddr().String(), "test-app"))
+		require.NotNil(t, syslogConn)
+
+		Configure(WithOutputFunc(nil))
+		assert.Nil(t, syslogConn, "expected the syslog connection to be released")
+		assert.Nil(t, outputFunc)
+	})
+
+	t.Run("fallback reported by ConfigureAtomic", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		original := &bytes.Buffer{}
+		Configure(WithOutput(original))
+
+		err := ConfigureAtomic(WithSyslog("invalid", "127.0.0.1:0", "test-app"))
+		assert.ErrorContains(t, err, "syslog unavailable")
+		assert.Equal(t, original, output, "expected the previous output to be kept")
+	})
+}
@@ -0,0 +1,82 @@
+package log
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// WithOutputTarget sets the output for the logger from a configuration string.
+// "stdout" and "stderr" select os.Stdout and os.Stderr, any other value is opened as a file path in append mode,
+// creating the file if needed. If the file can't be opened, os.Stdout is used instead and a warning is written
+// to os.Stderr; ConfigureAtomic reports the failure instead.
+//
+// The file opened by the previous WithOutputTarget is closed when another target is selected, and reused when
+// the same path still refers to the same file, so repeated reloads don't leak descriptors. A file moved away
+// by log rotation is replaced by a newly opened one.
+func WithOutputTarget(target string) LoggingOptions {
+	return func() {
+		mtx.Lock()
+		err := setOutputTarget(target)
+		mtx.Unlock()
+
+		if err != nil {
+			reportInvalidOption(fmt.Errorf("invalid output target %q: %w", target, err))
+			warnFallback("failed to open log output target, falling back to stdout", "target", target, "error", err)
+		}
+	}
+}
+
+// setOutputTarget opens target and sets it as the output, replacing the file opened by the previous target.
+// On failure, os.Stdout is set instead and the error is returned. The caller must hold mtx.
+func setOutputTarget(target string) error {
+	if targetFile != nil && target == targetPath && sameFile(targetFile, target) {
+		setOutput(targetFile)
+		return nil
+	}
+
+	out, err := openTarget(target)
+	if err != nil {
+		out = os.Stdout
+	}
+	setOutput(out)
+
+	if targetFile != nil {
+		release(targetFile)
+	}
+	targetFile, targetPath = nil, ""
+	if f, ok := out.(*os.File); ok && f != os.Stdout && f != os.Stderr {
+		acquire(f)
+		targetFile, targetPath = f, target
+	}
+	return err
+}
+
+// openTarget resolves target to a writer.
+func openTarget(target string) (io.Writer, error) {
+	switch target {
+	case "stdout":
+		return os.Stdout, nil
+	case "stderr":
+		return os.Stderr, nil
+	}
+
+	f, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// sameFile reports whether path still refers to the open file f.
+func sameFile(f *os.File, path string) bool {
+	open, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	current, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	return os.SameFile(open, current)
+}
@@ -0,0 +1,164 @@
+package log
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func TestWithOutputTarget(t *testing.T) {
+	defer resetLoggerConf()
+
+	t.Run("stdout", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		Configure(WithOutput(&bytes.Buffer{}), WithOutputTarget("stdout"))
+		assert.Equal(t, os.Stdout, output)
+	})
+
+	t.Run("stderr", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		Configure(WithOutputTarget("stderr"))
+		assert.Equal(t, os.Stderr, output)
+	})
+
+	t.Run("file", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		path := filepath.Join(t.TempDir(), "app.log")
+		require.NoError(t, os.WriteFile(path, []byte("existing\n"), 0o644))
+
+		Configure(WithOutputTarget(path))
+		f, ok := output.(*os.File)
+		require.True(t, ok, "expected output to be a file")
+		defer func() { _ = f.Close() }()
+
+		val := getRandomString()
+		Error(val)
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), "existing\n", "expected existing content to be kept")
+		assert.Contains(t, string(data), val)
+	})
+
+	t.Run("invalid path", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		_, stdout, stdoutCloser := changeStdout()
+		defer stdoutCloser()
+		r, w, closer := changeStderr()
+		defer closer()
+
+		path := filepath.Join(t.TempDir(), "missing", "app.log")
+		Configure(WithLogLevel("error"), WithOutputTarget(path))
+		assert.Equal(t, stdout, output, "expected fallback to stdout")
+
+		_ = w.Close()
+		out := &bytes.Buffer{}
+		_, _ = io.Copy(out, r)
+
+		assert.Contains(t, out.String(), "failed to open log output target", "expected the warning on stderr regardless of the level")
+		assert.Contains(t, out.String(), path)
+	})
+
+	t.Run("invalid path atomic", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		path := filepath.Join(t.TempDir(), "missing", "app.log")
+		err := ConfigureAtomic(WithOutput(&bytes.Buffer{}), WithOutputTarget(path))
+		assert.ErrorContains(t, err, "invalid output target")
+	})
+
+	t.Run("previous file closed on switch", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		dir := t.TempDir()
+		Configure(WithOutputTarget(filepath.Join(dir, "a.log")))
+		first, ok := output.(*os.File)
+		require.True(t, ok, "expected output to be a file")
+
+		Configure(WithOutputTarget(filepath.Join(dir, "b.log")))
+		second, ok := output.(*os.File)
+		require.True(t, ok, "expected output to be a file")
+		defer func() { _ = second.Close() }()
+
+		_, err := first.Write([]byte("x"))
+		assert.ErrorIs(t, err, os.ErrClosed, "expected the previous file to be closed")
+
+		Configure(WithOutputTarget("stdout"))
+		_, err = second.Write([]byte("x"))
+		assert.ErrorIs(t, err, os.ErrClosed, "expected the file to be closed when switching to stdout")
+	})
+
+	t.Run("same path reused", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		path := filepath.Join(t.TempDir(), "app.log")
+		Configure(WithOutputTarget(path))
+		first := output
+		Configure(WithOutputTarget(path))
+		defer func() { _ = first.(*os.File).Close() }()
+
+		assert.Same(t, first, output, "expected the open file to be reused")
+		_, err := first.Write([]byte("x"))
+		assert.NoError(t, err, "expected the reused file to stay open")
+	})
+
+	t.Run("rotated file reopened", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		dir := t.TempDir()
+		path := filepath.Join(dir, "app.log")
+		Configure(WithOutputTarget(path))
+		first := output.(*os.File)
+		require.NoError(t, os.Rename(path, filepath.Join(dir, "app.log.1")))
+
+		Configure(WithOutputTarget(path))
+		second := output.(*os.File)
+		defer func() { _ = second.Close() }()
+
+		assert.NotSame(t, first, second, "expected a new file after rotation")
+		_, err := first.Write([]byte("x"))
+		assert.ErrorIs(t, err, os.ErrClosed, "expected the rotated file to be closed")
+
+		Error("after rotation")
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), "after rotation")
+	})
+
+	t.Run("concurrent switches don't leak files", func(t *testing.T) {
+		defer resetLoggerConf()
+
+		dir := t.TempDir()
+		paths := []string{filepath.Join(dir, "a.log"), filepath.Join(dir, "b.log")}
+		Configure(WithOutputTarget(paths[0]))
+		fds, err := os.ReadDir("/proc/self/fd")
+		if err != nil {
+			t.Skip("open descriptors can't be counted on this platform")
+		}
+
+		var wg sync.WaitGroup
+		for i := range 50 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				Configure(WithOutputTarget(paths[i%2]))
+			}()
+		}
+		wg.Wait()
+		Configure(WithOutputTarget(paths[0]))
+
+		after, err := os.ReadDir("/proc/self/fd")
+		require.NoError(t, err)
+		assert.Len(t, after, len(fds), "expected the replaced files to be closed")
+		assert.Same(t, targetFile, output, "expected the recorded file to be the output")
+	})
+}